@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: api/calculator.proto
+
+package generated
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CalculateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Workload kind, empty means the server default (-workload)
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Workload size (digits for gcd), 0 means the server default
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Iterations, 0 means the server default
+	Iterations int32 `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Cache key, looked up in the server LRU when -cache-size is set; empty keys hit with -cache-hit-prob
+	Key           string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateRequest) Reset() {
+	*x = CalculateRequest{}
+	mi := &file_api_calculator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateRequest) ProtoMessage() {}
+
+func (x *CalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_calculator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateRequest.ProtoReflect.Descriptor instead.
+func (*CalculateRequest) Descriptor() ([]byte, []int) {
+	return file_api_calculator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CalculateRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalculateRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *CalculateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CalculateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Effective workload size
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Effective iterations
+	Iterations int32  `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Result     string `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	// Time spent queued in the worker pool, in milliseconds
+	QueueMs float64 `protobuf:"fixed64,5,opt,name=queue_ms,json=queueMs,proto3" json:"queue_ms,omitempty"`
+	// Time spent executing the workload, in milliseconds
+	ComputeMs float64 `protobuf:"fixed64,6,opt,name=compute_ms,json=computeMs,proto3" json:"compute_ms,omitempty"`
+	// Time spent calling downstream instances (-downstream), in milliseconds
+	DownstreamMs float64 `protobuf:"fixed64,7,opt,name=downstream_ms,json=downstreamMs,proto3" json:"downstream_ms,omitempty"`
+	// Per-hop timings of the downstream calls
+	Downstream []*Hop `protobuf:"bytes,8,rep,name=downstream,proto3" json:"downstream,omitempty"`
+	// "hit" or "miss", empty when the cache emulation is off
+	Cache         string `protobuf:"bytes,9,opt,name=cache,proto3" json:"cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateResponse) Reset() {
+	*x = CalculateResponse{}
+	mi := &file_api_calculator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateResponse) ProtoMessage() {}
+
+func (x *CalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_calculator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateResponse.ProtoReflect.Descriptor instead.
+func (*CalculateResponse) Descriptor() ([]byte, []int) {
+	return file_api_calculator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CalculateResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CalculateResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *CalculateResponse) GetQueueMs() float64 {
+	if x != nil {
+		return x.QueueMs
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetComputeMs() float64 {
+	if x != nil {
+		return x.ComputeMs
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetDownstreamMs() float64 {
+	if x != nil {
+		return x.DownstreamMs
+	}
+	return 0
+}
+
+func (x *CalculateResponse) GetDownstream() []*Hop {
+	if x != nil {
+		return x.Downstream
+	}
+	return nil
+}
+
+func (x *CalculateResponse) GetCache() string {
+	if x != nil {
+		return x.Cache
+	}
+	return ""
+}
+
+// Hop is one call to a downstream cpusim instance, including the hops behind it
+type Hop struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Target string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// HTTP status, 0 when the call failed before a response
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Round trip observed by the caller, in milliseconds
+	DurationMs float64 `protobuf:"fixed64,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// Downstream queueing and local work, from its Server-Timing header, in milliseconds
+	QueueMs       float64 `protobuf:"fixed64,4,opt,name=queue_ms,json=queueMs,proto3" json:"queue_ms,omitempty"`
+	ComputeMs     float64 `protobuf:"fixed64,5,opt,name=compute_ms,json=computeMs,proto3" json:"compute_ms,omitempty"`
+	Error         string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Downstream    []*Hop  `protobuf:"bytes,7,rep,name=downstream,proto3" json:"downstream,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hop) Reset() {
+	*x = Hop{}
+	mi := &file_api_calculator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hop) ProtoMessage() {}
+
+func (x *Hop) ProtoReflect() protoreflect.Message {
+	mi := &file_api_calculator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hop.ProtoReflect.Descriptor instead.
+func (*Hop) Descriptor() ([]byte, []int) {
+	return file_api_calculator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Hop) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Hop) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Hop) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Hop) GetQueueMs() float64 {
+	if x != nil {
+		return x.QueueMs
+	}
+	return 0
+}
+
+func (x *Hop) GetComputeMs() float64 {
+	if x != nil {
+		return x.ComputeMs
+	}
+	return 0
+}
+
+func (x *Hop) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Hop) GetDownstream() []*Hop {
+	if x != nil {
+		return x.Downstream
+	}
+	return nil
+}
+
+var File_api_calculator_proto protoreflect.FileDescriptor
+
+const file_api_calculator_proto_rawDesc = "" +
+	"\n" +
+	"\x14api/calculator.proto\x12\x14cpusim.calculator.v1\"l\n" +
+	"\x10CalculateRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x03 \x01(\x05R\n" +
+	"iterations\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\"\xa3\x02\n" +
+	"\x11CalculateResponse\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"iterations\x18\x03 \x01(\x05R\n" +
+	"iterations\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\x12\x19\n" +
+	"\bqueue_ms\x18\x05 \x01(\x01R\aqueueMs\x12\x1d\n" +
+	"\n" +
+	"compute_ms\x18\x06 \x01(\x01R\tcomputeMs\x12#\n" +
+	"\rdownstream_ms\x18\a \x01(\x01R\fdownstreamMs\x129\n" +
+	"\n" +
+	"downstream\x18\b \x03(\v2\x19.cpusim.calculator.v1.HopR\n" +
+	"downstream\x12\x14\n" +
+	"\x05cache\x18\t \x01(\tR\x05cache\"\xe1\x01\n" +
+	"\x03Hop\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x01R\n" +
+	"durationMs\x12\x19\n" +
+	"\bqueue_ms\x18\x04 \x01(\x01R\aqueueMs\x12\x1d\n" +
+	"\n" +
+	"compute_ms\x18\x05 \x01(\x01R\tcomputeMs\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"downstream\x18\a \x03(\v2\x19.cpusim.calculator.v1.HopR\n" +
+	"downstream2j\n" +
+	"\n" +
+	"Calculator\x12\\\n" +
+	"\tCalculate\x12&.cpusim.calculator.v1.CalculateRequest\x1a'.cpusim.calculator.v1.CalculateResponseB!Z\x1fcpusim/calculator/api/generatedb\x06proto3"
+
+var (
+	file_api_calculator_proto_rawDescOnce sync.Once
+	file_api_calculator_proto_rawDescData []byte
+)
+
+func file_api_calculator_proto_rawDescGZIP() []byte {
+	file_api_calculator_proto_rawDescOnce.Do(func() {
+		file_api_calculator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_calculator_proto_rawDesc), len(file_api_calculator_proto_rawDesc)))
+	})
+	return file_api_calculator_proto_rawDescData
+}
+
+var file_api_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_calculator_proto_goTypes = []any{
+	(*CalculateRequest)(nil),  // 0: cpusim.calculator.v1.CalculateRequest
+	(*CalculateResponse)(nil), // 1: cpusim.calculator.v1.CalculateResponse
+	(*Hop)(nil),               // 2: cpusim.calculator.v1.Hop
+}
+var file_api_calculator_proto_depIdxs = []int32{
+	2, // 0: cpusim.calculator.v1.CalculateResponse.downstream:type_name -> cpusim.calculator.v1.Hop
+	2, // 1: cpusim.calculator.v1.Hop.downstream:type_name -> cpusim.calculator.v1.Hop
+	0, // 2: cpusim.calculator.v1.Calculator.Calculate:input_type -> cpusim.calculator.v1.CalculateRequest
+	1, // 3: cpusim.calculator.v1.Calculator.Calculate:output_type -> cpusim.calculator.v1.CalculateResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_calculator_proto_init() }
+func file_api_calculator_proto_init() {
+	if File_api_calculator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_calculator_proto_rawDesc), len(file_api_calculator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_calculator_proto_goTypes,
+		DependencyIndexes: file_api_calculator_proto_depIdxs,
+		MessageInfos:      file_api_calculator_proto_msgTypes,
+	}.Build()
+	File_api_calculator_proto = out.File
+	file_api_calculator_proto_goTypes = nil
+	file_api_calculator_proto_depIdxs = nil
+}
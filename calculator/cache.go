@@ -0,0 +1,171 @@
+package calculator
+
+import (
+	"container/list"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// maxCacheSize 限制 LRU 的条目数，避免误配置耗尽内存
+const maxCacheSize = 10_000_000
+
+// CacheConfig 描述缓存命中仿真：命中的请求只做极少的工作，未命中的请求执行完整负载
+type CacheConfig struct {
+	// HitProb 请求命中的概率，用于未携带 key 或未启用 LRU 的请求，0 表示总是未命中
+	HitProb float64 `json:"hit_prob"`
+	// Size 按 key 缓存的 LRU 容量（条目数），0 表示不按 key 缓存；
+	// 启用后携带 key 的请求只有在 key 仍在 LRU 中时才命中，命中率由 key 的分布和容量决定
+	Size int `json:"size"`
+}
+
+// Enabled 报告缓存仿真是否开启
+func (c CacheConfig) Enabled() bool {
+	return c.HitProb > 0 || c.Size > 0
+}
+
+// Validate 检查配置范围
+func (c CacheConfig) Validate() error {
+	if c.HitProb < 0 || c.HitProb > 1 {
+		return fmt.Errorf("cache hit probability must be in [0, 1], got %v", c.HitProb)
+	}
+	if c.Size < 0 || c.Size > maxCacheSize {
+		return fmt.Errorf("cache size must be in [0, %d], got %d", maxCacheSize, c.Size)
+	}
+	return nil
+}
+
+// CacheStats 是缓存计数器的快照，自上次配置以来累计
+type CacheStats struct {
+	Config   CacheConfig `json:"config"`
+	Hits     int64       `json:"hits"`
+	Misses   int64       `json:"misses"`
+	HitRatio float64     `json:"hit_ratio"`
+	Entries  int         `json:"entries"` // 当前 LRU 中的 key 数
+}
+
+// cacheEntry 是 LRU 中的一个条目
+type cacheEntry struct {
+	key   string
+	value string
+}
+
+// Cache 仿真请求级缓存。可被并发调用，配置可在运行时修改。
+type Cache struct {
+	mu  sync.Mutex
+	cfg CacheConfig
+	rng *rand.Rand
+
+	// LRU：链表头部为最近使用的条目
+	order *list.List
+	items map[string]*list.Element
+
+	hits   int64
+	misses int64
+}
+
+// NewCache 校验配置并创建缓存
+func NewCache(cfg CacheConfig) (*Cache, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Cache{
+		cfg:   cfg,
+		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}, nil
+}
+
+// Configure 替换配置并清空 LRU 和计数器
+func (c *Cache) Configure(cfg CacheConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cfg = cfg
+	c.order.Init()
+	clear(c.items)
+	c.hits, c.misses = 0, 0
+	return nil
+}
+
+// Config 返回当前配置
+func (c *Cache) Config() CacheConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cfg
+}
+
+// Lookup 判断请求是否命中。enabled 为 false 时缓存关闭，不计数；
+// 命中时 value 为之前 Store 的结果，按概率命中的请求没有缓存值，value 为空。
+func (c *Cache) Lookup(key string) (value string, hit, enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.cfg.Enabled() {
+		return "", false, false
+	}
+
+	if key != "" && c.cfg.Size > 0 {
+		if elem, ok := c.items[key]; ok {
+			c.order.MoveToFront(elem)
+			c.hits++
+			return elem.Value.(*cacheEntry).value, true, true
+		}
+		c.misses++
+		return "", false, true
+	}
+
+	if c.rng.Float64() < c.cfg.HitProb {
+		c.hits++
+		return "", true, true
+	}
+	c.misses++
+	return "", false, true
+}
+
+// Store 在 LRU 中记录 key 的结果，容量满时淘汰最久未使用的条目。未启用 LRU 或 key 为空时不做任何事。
+func (c *Cache) Store(key, value string) {
+	if key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cfg.Size == 0 {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*cacheEntry).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	if c.order.Len() >= c.cfg.Size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+	c.items[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+}
+
+// Stats 返回计数器快照
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := CacheStats{
+		Config:  c.cfg,
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: c.order.Len(),
+	}
+	if total := c.hits + c.misses; total > 0 {
+		s.HitRatio = float64(c.hits) / float64(total)
+	}
+	return s
+}
@@ -1,57 +1,180 @@
 package calculator
 
 import (
+	"fmt"
+	"math"
 	"math/big"
+	"sort"
+	"sync"
 )
 
+// 使用两个大的斐波那契数作为固定值（约1200位）
+// 这些数字足够大，可以产生稳定的计算负载
+const (
+	fixedAStr = "288006719437081612064461045582996248318550191762069222439730410477812745867621795364311733487869513185942983695215476169383038903552804435414094508834202466589196823871807977202629637704020007230465262130782791032317015620246305172639458422481088108673357991616220899406861219438147392905162253408001430063845979389727462365854374356728987645678976543213456789087654321123456789087654323456789087654323456789087654323456789087654323456789087654323456789087654323456789098765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543"
+	fixedBStr = "177997941600471418946732056639426180444608155543879635266733371758935892661156742406886684150292390815978219096362476722318836336535163521669916203376029293201875906689536935790127946702623414158718880281706210784320499487923219672450403132661529622254520525083630232403761214899614838044055207314007924009787654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543"
+)
+
+// fixedOperands 只解析一次固定操作数，之后所有 Calculator 共享同一组只读的大整数
+var fixedOperands = sync.OnceValue(func() [2]*big.Int {
+	a, okA := new(big.Int).SetString(fixedAStr, 10)
+	b, okB := new(big.Int).SetString(fixedBStr, 10)
+	if !okA || !okB {
+		panic("calculator: invalid fixed operands")
+	}
+	return [2]*big.Int{a, b}
+})
+
+// gcdScratch 是 GCD 循环使用的临时大整数，通过 sync.Pool 在请求之间复用，避免每步分配
+type gcdScratch struct {
+	x, y, t, q big.Int
+}
+
+var gcdScratchPool = sync.Pool{New: func() any { return new(gcdScratch) }}
+
+// Calculator 持有已注册的负载。Lookup/Resolve/Run 可以被并发调用，
+// Register 需要在开始并发使用之前完成。
 type Calculator struct {
-	// 预定义的大整数，用于固定计算负载
+	// 预定义的大整数，用于固定计算负载（所有实例共享，只读）
 	fixedA *big.Int
 	fixedB *big.Int
+
+	// 固定操作数的位数，作为 gcd 负载的默认规模
+	fixedDigits int
+
+	// 已注册的负载，key 为负载名称
+	workloads map[string]Workload
 }
 
+// New 创建注册了内置负载的 Calculator。固定操作数只在第一次调用时解析，
+// 因此 New 本身开销很小，但仍建议在服务启动时创建一次并在请求间复用。
 func New() *Calculator {
-	// 使用两个大的斐波那契数作为固定值（约1200位）
-	// 这些数字足够大，可以产生稳定的计算负载
-	aStr := "288006719437081612064461045582996248318550191762069222439730410477812745867621795364311733487869513185942983695215476169383038903552804435414094508834202466589196823871807977202629637704020007230465262130782791032317015620246305172639458422481088108673357991616220899406861219438147392905162253408001430063845979389727462365854374356728987645678976543213456789087654321123456789087654323456789087654323456789087654323456789087654323456789087654323456789087654323456789098765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543"
-	bStr := "177997941600471418946732056639426180444608155543879635266733371758935892661156742406886684150292390815978219096362476722318836336535163521669916203376029293201875906689536935790127946702623414158718880281706210784320499487923219672450403132661529622254520525083630232403761214899614838044055207314007924009787654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543212345678908765432123456789087654321234567890876543"
-
+	ops := fixedOperands()
 	c := &Calculator{
-		fixedA: new(big.Int),
-		fixedB: new(big.Int),
+		fixedA:      ops[0],
+		fixedB:      ops[1],
+		fixedDigits: len(fixedAStr),
 	}
 
-	c.fixedA.SetString(aStr, 10)
-	c.fixedB.SetString(bStr, 10)
+	c.workloads = make(map[string]Workload)
+	for _, w := range builtinWorkloads(c) {
+		c.Register(w)
+	}
 
 	return c
 }
 
+// Register 注册一个负载，同名负载会被覆盖
+func (c *Calculator) Register(w Workload) {
+	c.workloads[w.Name()] = w
+}
+
+// Kinds 返回所有已注册的负载名称（按字母排序）
+func (c *Calculator) Kinds() []string {
+	kinds := make([]string, 0, len(c.workloads))
+	for name := range c.workloads {
+		kinds = append(kinds, name)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Lookup 按名称查找已注册的负载
+func (c *Calculator) Lookup(kind string) (Workload, bool) {
+	w, ok := c.workloads[kind]
+	return w, ok
+}
+
+// Resolve 查找指定类型的负载并补全、校验参数，kind 为空时使用 DefaultKind，
+// p 中为零的字段使用该负载的默认值
+func (c *Calculator) Resolve(kind string, p Params) (Workload, Params, error) {
+	if kind == "" {
+		kind = DefaultKind
+	}
+	w, ok := c.Lookup(kind)
+	if !ok {
+		return nil, p, fmt.Errorf("%w: %s", ErrUnknownWorkload, kind)
+	}
+
+	p, err := resolveParams(w, p)
+	if err != nil {
+		return nil, p, err
+	}
+	return w, p, nil
+}
+
+// Run 执行指定类型的负载，返回结果中包含实际生效的参数
+func (c *Calculator) Run(kind string, p Params) (*Result, error) {
+	w, p, err := c.Resolve(kind, p)
+	if err != nil {
+		return nil, err
+	}
+	return Execute(w, p), nil
+}
+
+// Execute 按已解析的参数执行负载，不再校验参数（允许 Iterations 为 0）
+func Execute(w Workload, p Params) *Result {
+	return &Result{
+		Kind:   w.Name(),
+		Params: p,
+		Value:  w.Run(p),
+	}
+}
+
 // GCD 计算预定义大整数的最大公约数
 // 为了增加计算开销，计算 5 次以屏蔽网络波动的影响
 func (c *Calculator) GCD() *big.Int {
-	var result *big.Int
+	return gcd(c.fixedA, c.fixedB, 5)
+}
+
+// gcd 使用欧几里得算法计算 a、b 的最大公约数，重复 iterations 次以放大计算开销。
+// a、b 不会被修改；循环使用池化的临时变量，只有返回值会分配。
+func gcd(a, b *big.Int, iterations int) *big.Int {
+	result := new(big.Int)
+	if iterations <= 0 {
+		return result
+	}
 
-	// 计算 5 次，增加 5 倍计算开销
-	for i := 0; i < 5; i++ {
-		// 使用固定的大整数进行计算
-		x := new(big.Int).Set(c.fixedA)
-		y := new(big.Int).Set(c.fixedB)
+	s := gcdScratchPool.Get().(*gcdScratch)
+	defer gcdScratchPool.Put(s)
 
+	for i := 0; i < iterations; i++ {
+		x, y, t := &s.x, &s.y, &s.t
+		x.Set(a)
+		y.Set(b)
+
+		// 操作数为正数，截断除法的余数即模；QuoRem 复用 q 的缓冲区，而 Mod 每步都会分配商
 		for y.Sign() != 0 {
-			temp := new(big.Int)
-			temp.Mod(x, y)
-			x = y
-			y = temp
+			s.q.QuoRem(x, y, t)
+			x, y, t = y, t, x
 		}
 
-		result = x
+		if i == iterations-1 {
+			result.Set(x)
+		}
 	}
 
 	return result
 }
 
-// GetFixedNumbers 返回当前使用的固定大整数
+// gcdSteps 执行一次 a、b 的欧几里得算法的前 fraction 部分，用于服务时间分布产生的不完整的一轮。
+// 总步数按 Lochs 定理估计：n 位十进制数平均约需 1.94n 步，即每个二进制位约 0.584 步。
+func gcdSteps(a, b *big.Int, fraction float64) {
+	steps := int(math.Ceil(fraction * 0.584 * float64(a.BitLen())))
+
+	s := gcdScratchPool.Get().(*gcdScratch)
+	defer gcdScratchPool.Put(s)
+
+	x, y, t := &s.x, &s.y, &s.t
+	x.Set(a)
+	y.Set(b)
+	for i := 0; i < steps && y.Sign() != 0; i++ {
+		s.q.QuoRem(x, y, t)
+		x, y, t = y, t, x
+	}
+}
+
+// GetFixedNumbers 返回当前使用的固定大整数，调用方不能修改返回值
 func (c *Calculator) GetFixedNumbers() (a, b *big.Int) {
 	return c.fixedA, c.fixedB
 }
@@ -0,0 +1,421 @@
+package calculator
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGCDMatchesMathBig(t *testing.T) {
+	c := New()
+	a, b := c.GetFixedNumbers()
+	want := new(big.Int).GCD(nil, nil, a, b)
+
+	for _, iterations := range []int{1, 2, 5} {
+		if got := gcd(a, b, iterations); got.Cmp(want) != 0 {
+			t.Errorf("gcd(iterations=%d) = %s, want %s", iterations, got, want)
+		}
+	}
+	if got := gcd(a, b, 0); got.Sign() != 0 {
+		t.Errorf("gcd(iterations=0) = %s, want 0", got)
+	}
+
+	// The shared operands must not be modified by the GCD loop
+	if a.String() != fixedAStr || b.String() != fixedBStr {
+		t.Fatal("gcd modified the shared fixed operands")
+	}
+}
+
+func TestGCDRandomOperands(t *testing.T) {
+	c := New()
+	for _, size := range []int{10, 500, 3000} {
+		ops := gcdOperands.get(size)
+		want := new(big.Int).GCD(nil, nil, ops[0], ops[1]).String()
+
+		res, err := c.Run("gcd", Params{Size: size, Iterations: 2})
+		if err != nil {
+			t.Fatalf("Run(size=%d): %v", size, err)
+		}
+		if res.Value != want {
+			t.Errorf("Run(size=%d) = %s, want %s", size, res.Value, want)
+		}
+	}
+}
+
+func TestResolveLimits(t *testing.T) {
+	c := New()
+	for _, tc := range []struct {
+		kind string
+		p    Params
+		ok   bool
+	}{
+		{"sha256", Params{Size: 16 << 20, Iterations: 1}, true},
+		{"sha256", Params{Size: 16<<20 + 1, Iterations: 1}, false},
+		{"sha256", Params{Size: 16 << 20, Iterations: 1025}, false},
+		{"matrix", Params{Size: 1024, Iterations: 18}, true},
+		{"matrix", Params{Size: 1024, Iterations: 19}, false},
+		{"stream", Params{Size: 8 << 20, Iterations: 1024}, true},
+		{"stream", Params{Size: 8 << 20, Iterations: 1025}, false},
+		{"pointer-chase", Params{Size: 16 << 20, Iterations: MaxIterations}, true},
+		{"sleep", Params{Size: 60_000, Iterations: 1}, true},
+		{"sleep", Params{Size: 60_000, Iterations: 2}, false},
+		{"sleep", Params{Size: 1, Iterations: 60_001}, false},
+		{"gcd", Params{Size: 100_000, Iterations: 2}, true},
+		{"gcd", Params{Size: 100_000, Iterations: 3}, false},
+	} {
+		_, _, err := c.Resolve(tc.kind, tc.p)
+		if tc.ok && err != nil {
+			t.Errorf("Resolve(%s, %+v) = %v, want ok", tc.kind, tc.p, err)
+		}
+		if !tc.ok && !errors.Is(err, ErrInvalidParams) {
+			t.Errorf("Resolve(%s, %+v) = %v, want ErrInvalidParams", tc.kind, tc.p, err)
+		}
+	}
+}
+
+func TestSizeCacheBuildsOnce(t *testing.T) {
+	var builds atomic.Int32
+	release := make(chan struct{})
+	c := newSizeCache(func(size int) int {
+		builds.Add(1)
+		if size == 1 {
+			<-release
+		}
+		return size * 10
+	}, func(int) int64 { return 1 })
+
+	// A slow build of one size must not block other sizes
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := c.get(1); got != 10 {
+				t.Errorf("get(1) = %d, want 10", got)
+			}
+		}()
+	}
+	if got := c.get(2); got != 20 {
+		t.Errorf("get(2) = %d, want 20", got)
+	}
+	close(release)
+	wg.Wait()
+
+	if got := builds.Load(); got != 2 {
+		t.Errorf("built %d times, want once per size (2)", got)
+	}
+}
+
+func TestSizeCacheByteBudget(t *testing.T) {
+	var builds atomic.Int32
+	c := newSizeCache(func(size int) int {
+		builds.Add(1)
+		return size
+	}, func(int) int64 { return maxCachedBytes + 1 })
+
+	// Data larger than the budget is built for every request and never cached
+	c.get(1)
+	c.get(1)
+	if got := builds.Load(); got != 2 {
+		t.Errorf("built %d times, want 2 for uncacheable data", got)
+	}
+	if len(c.items) != 0 {
+		t.Errorf("cached %d sizes over the byte budget", len(c.items))
+	}
+}
+
+func TestServiceTimeDistMoments(t *testing.T) {
+	c := New()
+	w, _ := c.Lookup("gcd")
+	const samples = 200_000
+
+	bimodalCV := func(p, r float64) float64 {
+		fast := 1 / (1 - p + p*r)
+		return math.Sqrt(fast*fast*((1-p)+p*r*r) - 1)
+	}
+	for _, tc := range []struct {
+		cfg    DistConfig
+		wantCV float64
+	}{
+		{DistConfig{Kind: DistDeterministic}, 0},
+		{DistConfig{Kind: DistExponential}, 1},
+		{DistConfig{Kind: DistLognormal, Sigma: 0.5}, math.Sqrt(math.Exp(0.25) - 1)},
+		{DistConfig{Kind: DistBimodal, BimodalProb: 0.1, BimodalRatio: 10}, bimodalCV(0.1, 10)},
+	} {
+		// A base of a single iteration must still give the configured distribution, not a discrete one
+		for _, base := range []int{1, 50} {
+			tc.cfg.Seed = 1
+			d, err := NewServiceTimeDist(tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var sum, sumSq float64
+			zero := 0
+			for range samples {
+				p := d.Apply(w, Params{Size: 100, Iterations: base})
+				work := (float64(p.Iterations) + p.Fraction) / float64(base)
+				if p.Fraction < 0 || p.Fraction >= 1 {
+					t.Fatalf("%s: fraction %v out of [0, 1)", tc.cfg.Kind, p.Fraction)
+				}
+				if work == 0 {
+					zero++
+				}
+				sum += work
+				sumSq += work * work
+			}
+			mean := sum / samples
+			cv := math.Sqrt(sumSq/samples-mean*mean) / mean
+
+			if math.Abs(mean-1) > 0.02 {
+				t.Errorf("%s (base %d): mean work = %.4f, want 1", tc.cfg.Kind, base, mean)
+			}
+			if math.Abs(cv-tc.wantCV) > 0.03 {
+				t.Errorf("%s (base %d): CV = %.4f, want %.4f", tc.cfg.Kind, base, cv, tc.wantCV)
+			}
+			if zero > 0 {
+				t.Errorf("%s (base %d): %d requests did no work", tc.cfg.Kind, base, zero)
+			}
+		}
+	}
+}
+
+func TestWorkloadsRunFraction(t *testing.T) {
+	c := New()
+	for _, kind := range c.Kinds() {
+		w, _ := c.Lookup(kind)
+		p := Params{Size: 16, Iterations: 0, Fraction: 0.5}
+		if kind == "sleep" {
+			p.Size = 1
+		}
+		// A partial round alone must run without touching data past the round's end
+		if got := Execute(w, p); got.Value == "" {
+			t.Errorf("%s: empty result for %+v", kind, p)
+		}
+	}
+}
+
+func TestServiceTimeDistWorkLimit(t *testing.T) {
+	c := New()
+	w, _ := c.Lookup("sleep")
+	d, err := NewServiceTimeDist(DistConfig{Kind: DistBimodal, Seed: 1, BimodalProb: 0.5, BimodalRatio: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Scaled work must stay within the limits a request is validated against
+	for range 1000 {
+		p := d.Apply(w, Params{Size: 30_000, Iterations: 1})
+		if float64(p.Size)*(float64(p.Iterations)+p.Fraction) > float64(w.MaxWork()) {
+			t.Fatalf("Apply = %+v, exceeds max work %d", p, w.MaxWork())
+		}
+	}
+}
+
+func TestProfileRoundTrip(t *testing.T) {
+	// Concurrency 1 serves 1 request per ms, concurrency 2 only 1.5x as much
+	one := make([]float64, 1000)
+	two := make([]float64, 1500)
+	for i := range one {
+		one[i] = 1
+	}
+	for i := range two {
+		two[i] = 4.0 / 3
+	}
+	levels := []ProfileLevel{
+		NewProfileLevel(1, one, time.Second),
+		NewProfileLevel(2, two, time.Second),
+	}
+	profile := NewProfile("gcd", Params{Size: 1200, Iterations: 5}, DistConfig{Kind: DistDeterministic}, time.Second, 2, 2, levels)
+
+	path := filepath.Join(t.TempDir(), "profile.json")
+	data, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !loaded.CreatedAt.Equal(profile.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", loaded.CreatedAt, profile.CreatedAt)
+	}
+	loaded.CreatedAt = profile.CreatedAt
+	if !reflect.DeepEqual(loaded, profile) {
+		t.Errorf("LoadProfile = %+v, want %+v", loaded, profile)
+	}
+
+	if profile.ServiceRate != 1000 || profile.PeakThroughput != 1500 || profile.PeakConcurrency != 2 {
+		t.Errorf("service rate %v, peak %v at %d, want 1000, 1500 at 2",
+			profile.ServiceRate, profile.PeakThroughput, profile.PeakConcurrency)
+	}
+	if eff := profile.Levels[1].ScalingEfficiency; eff != 0.75 {
+		t.Errorf("scaling efficiency at concurrency 2 = %v, want 0.75", eff)
+	}
+	for _, tc := range []struct {
+		workers int
+		want    float64
+	}{{0, 1500}, {1, 1000}, {2, 1500}, {8, 1500}} {
+		if got := loaded.Capacity(tc.workers); got != tc.want {
+			t.Errorf("Capacity(%d) = %v, want %v", tc.workers, got, tc.want)
+		}
+	}
+
+	// A profile without levels cannot be used for capacity estimates
+	if err := os.WriteFile(path, []byte(`{"kind":"gcd","levels":[]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadProfile(path); err == nil {
+		t.Error("LoadProfile accepted a profile without levels")
+	}
+}
+
+func TestSuggestQPSRange(t *testing.T) {
+	for _, tc := range []struct {
+		capacity       float64
+		steps, maxQPS  int
+		min, max, step int
+	}{
+		{1000, 9, 0, 100, 900, 100},
+		{1000, 9, 1000, 100, 900, 100},
+		{5000, 9, 1000, 500, 1000, 62},      // capped at maxQPS
+		{50000, 9, 1000, 1000, 1000, 1},     // even the lowest utilization is above maxQPS
+		{3, 9, 1000, 1, 3, 1},               // at least 1 QPS and a step of 1
+		{0, 9, 1000, 1, 1, 1},               // no capacity
+		{100, 1, 1000, 10, 90, 80},          // at least two points
+		{100000, 9, 0, 10000, 90000, 10000}, // unlimited
+	} {
+		qpsMin, qpsMax, qpsStep := SuggestQPSRange(tc.capacity, tc.steps, tc.maxQPS)
+		if qpsMin != tc.min || qpsMax != tc.max || qpsStep != tc.step {
+			t.Errorf("SuggestQPSRange(%v, %d, %d) = %d, %d, %d, want %d, %d, %d",
+				tc.capacity, tc.steps, tc.maxQPS, qpsMin, qpsMax, qpsStep, tc.min, tc.max, tc.step)
+		}
+	}
+}
+
+// BenchmarkParseOperands measures what every request used to pay when the operands were parsed per request
+func BenchmarkParseOperands(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		new(big.Int).SetString(fixedAStr, 10)
+		new(big.Int).SetString(fixedBStr, 10)
+	}
+}
+
+// BenchmarkNew measures the per-instance setup cost left after sharing the parsed operands
+func BenchmarkNew(b *testing.B) {
+	New()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New()
+	}
+}
+
+// BenchmarkGCD measures the default gcd workload alone, which should dominate the service time
+func BenchmarkGCD(b *testing.B) {
+	c := New()
+	w, p, err := c.Resolve("gcd", Params{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Execute(w, p)
+	}
+}
+
+// BenchmarkGCDParallel checks that the shared operands and pooled scratch scale across goroutines
+func BenchmarkGCDParallel(b *testing.B) {
+	c := New()
+	w, p, err := c.Resolve("gcd", Params{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			Execute(w, p)
+		}
+	})
+}
+
+// BenchmarkWorkloads runs every built-in workload with its default params
+func BenchmarkWorkloads(b *testing.B) {
+	c := New()
+	for _, kind := range c.Kinds() {
+		if kind == "sleep" {
+			continue
+		}
+		b.Run(kind, func(b *testing.B) {
+			w, p, err := c.Resolve(kind, Params{})
+			if err != nil {
+				b.Fatal(err)
+			}
+			// Build the shared data outside the timed loop
+			Execute(w, p)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				Execute(w, p)
+			}
+		})
+	}
+}
+
+func TestCacheLRU(t *testing.T) {
+	c, err := NewCache(CacheConfig{Size: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lookup := func(key string) bool {
+		_, hit, _ := c.Lookup(key)
+		if !hit {
+			c.Store(key, key)
+		}
+		return hit
+	}
+
+	// a and b fill the cache, touching a makes b the least recently used, c evicts b
+	for _, step := range []struct {
+		key string
+		hit bool
+	}{{"a", false}, {"b", false}, {"a", true}, {"c", false}, {"b", false}, {"a", false}} {
+		if got := lookup(step.key); got != step.hit {
+			t.Fatalf("Lookup(%q) hit = %v, want %v", step.key, got, step.hit)
+		}
+	}
+
+	st := c.Stats()
+	if st.Hits != 1 || st.Misses != 5 || st.Entries != 2 {
+		t.Errorf("Stats() = %+v, want 1 hit, 5 misses, 2 entries", st)
+	}
+
+	// Disabled caches never hit and do not count
+	if err := c.Configure(CacheConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, hit, enabled := c.Lookup("a"); hit || enabled {
+		t.Errorf("disabled cache Lookup = hit %v, enabled %v", hit, enabled)
+	}
+	if st := c.Stats(); st.Hits+st.Misses != 0 {
+		t.Errorf("disabled cache counted %d lookups", st.Hits+st.Misses)
+	}
+}
@@ -0,0 +1,126 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 服务时间分布类型
+const (
+	DistDeterministic = "deterministic" // 每个请求工作量相同（M/D/1）
+	DistExponential   = "exponential"   // 指数分布（M/M/1）
+	DistLognormal     = "lognormal"     // 对数正态分布，重尾（M/G/1）
+	DistBimodal       = "bimodal"       // 双峰分布：大部分快请求 + 少量慢请求
+)
+
+// DistConfig 描述每个请求工作量的随机分布
+type DistConfig struct {
+	Kind string `json:"kind"`
+	// Seed 随机种子，为 0 时使用当前时间
+	Seed int64 `json:"seed"`
+	// Sigma 对数正态分布中 ln(X) 的标准差，越大尾部越重
+	Sigma float64 `json:"sigma,omitempty"`
+	// BimodalProb 双峰分布中慢请求的概率
+	BimodalProb float64 `json:"bimodal_prob,omitempty"`
+	// BimodalRatio 双峰分布中慢请求与快请求的工作量之比
+	BimodalRatio float64 `json:"bimodal_ratio,omitempty"`
+}
+
+// ServiceTimeDist 按配置的分布为每个请求抽取工作量倍率（均值为 1），
+// 并据此连续地缩放工作量，使平均服务时间与确定性模式一致。可被并发调用。
+type ServiceTimeDist struct {
+	cfg DistConfig
+
+	// rngs 池化的随机源，避免并发请求争用同一把锁。第 k 个随机源的种子为 Seed+k
+	rngs    sync.Pool
+	streams atomic.Int64
+}
+
+// NewServiceTimeDist 校验配置并创建分布
+func NewServiceTimeDist(cfg DistConfig) (*ServiceTimeDist, error) {
+	if cfg.Kind == "" {
+		cfg.Kind = DistDeterministic
+	}
+
+	switch cfg.Kind {
+	case DistDeterministic, DistExponential:
+	case DistLognormal:
+		if cfg.Sigma <= 0 {
+			return nil, fmt.Errorf("lognormal sigma must be positive, got %v", cfg.Sigma)
+		}
+	case DistBimodal:
+		if cfg.BimodalProb <= 0 || cfg.BimodalProb >= 1 {
+			return nil, fmt.Errorf("bimodal probability must be in (0, 1), got %v", cfg.BimodalProb)
+		}
+		if cfg.BimodalRatio < 1 {
+			return nil, fmt.Errorf("bimodal ratio must be >= 1, got %v", cfg.BimodalRatio)
+		}
+	default:
+		return nil, fmt.Errorf("unknown service time distribution: %s", cfg.Kind)
+	}
+
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+
+	d := &ServiceTimeDist{cfg: cfg}
+	d.rngs.New = func() any {
+		return rand.New(rand.NewSource(cfg.Seed + d.streams.Add(1) - 1))
+	}
+	return d, nil
+}
+
+// Config 返回生效的分布配置（包括实际使用的种子）
+func (d *ServiceTimeDist) Config() DistConfig {
+	return d.cfg
+}
+
+// Apply 按抽取的倍率缩放 w 的工作量：Iterations 取缩放后的整数部分，小数部分放入 Fraction，
+// 因此工作量是连续的，倍率很小时只执行一轮的一部分。缩放后的工作量不超过 MaxIterations
+// 和 w.MaxWork()。确定性分布原样返回 p。
+func (d *ServiceTimeDist) Apply(w Workload, p Params) Params {
+	if d.cfg.Kind == DistDeterministic {
+		return p
+	}
+
+	rng := d.rngs.Get().(*rand.Rand)
+	m := d.sample(rng)
+	d.rngs.Put(rng)
+
+	limit := float64(MaxIterations)
+	if perIteration := w.Work(Params{Size: p.Size, Iterations: 1}); perIteration > 0 {
+		limit = min(limit, float64(w.MaxWork()/perIteration))
+	}
+
+	scaled := min((float64(p.Iterations)+p.Fraction)*m, limit)
+	whole := math.Floor(scaled)
+	p.Iterations = int(whole)
+	p.Fraction = scaled - whole
+	return p
+}
+
+// sample 用 rng 抽取一个均值为 1 的倍率
+func (d *ServiceTimeDist) sample(rng *rand.Rand) float64 {
+	switch d.cfg.Kind {
+	case DistExponential:
+		return rng.ExpFloat64()
+	case DistLognormal:
+		// E[exp(σZ - σ²/2)] = 1
+		s := d.cfg.Sigma
+		return math.Exp(s*rng.NormFloat64() - s*s/2)
+	case DistBimodal:
+		// 快请求倍率 f 满足 f·(1-p) + f·r·p = 1
+		p, r := d.cfg.BimodalProb, d.cfg.BimodalRatio
+		fast := 1 / (1 - p + p*r)
+		if rng.Float64() < p {
+			return fast * r
+		}
+		return fast
+	default:
+		return 1
+	}
+}
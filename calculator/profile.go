@@ -0,0 +1,221 @@
+package calculator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"time"
+)
+
+// 校准后推荐的利用率扫描范围
+const (
+	suggestMinUtilization = 0.1
+	suggestMaxUtilization = 0.9
+)
+
+// Profile 是 calibrate 模式生成的服务时间画像：在并发 1..N 下测得的服务时间分布与吞吐，
+// 供 dashboard 预估容量并选择实验的 QPS 范围
+type Profile struct {
+	Kind       string         `json:"kind"`
+	Params     Params         `json:"params"`
+	Dist       DistConfig     `json:"dist"`
+	CreatedAt  time.Time      `json:"created_at"`
+	NumCPU     int            `json:"num_cpu"`
+	GOMAXPROCS int            `json:"gomaxprocs"`
+	LevelSecs  float64        `json:"level_seconds"` // 每个并发级别的测量时长
+	Levels     []ProfileLevel `json:"levels"`
+
+	// ServiceRate 单核服务率 μ（请求/秒），取并发为 1 时的测量值
+	ServiceRate float64 `json:"service_rate"`
+	// PeakThroughput 所有并发级别中的最大吞吐（请求/秒）
+	PeakThroughput float64 `json:"peak_throughput"`
+	// PeakConcurrency 达到最大吞吐时的并发数
+	PeakConcurrency int `json:"peak_concurrency"`
+}
+
+// ProfileLevel 是某个并发级别下的测量结果，时间单位均为毫秒
+type ProfileLevel struct {
+	Concurrency int     `json:"concurrency"`
+	Requests    int64   `json:"requests"`
+	Throughput  float64 `json:"throughput"`          // 总吞吐（请求/秒）
+	PerCore     float64 `json:"throughput_per_core"` // 每个并发工作者的吞吐
+	ServiceRate float64 `json:"service_rate"`        // μ = 1/E[S]（请求/秒）
+	// ScalingEfficiency 吞吐相对于并发为 1 时线性扩展的比例，1 表示完美扩展
+	ScalingEfficiency float64 `json:"scaling_efficiency"`
+
+	Mean   float64 `json:"mean_ms"`
+	Stddev float64 `json:"stddev_ms"`
+	CV     float64 `json:"cv"` // 变异系数 σ/μ，M/G/1 模型中的 C_s
+	Min    float64 `json:"min_ms"`
+	P50    float64 `json:"p50_ms"`
+	P90    float64 `json:"p90_ms"`
+	P95    float64 `json:"p95_ms"`
+	P99    float64 `json:"p99_ms"`
+	P999   float64 `json:"p999_ms"`
+	Max    float64 `json:"max_ms"`
+
+	Histogram []HistogramBucket `json:"histogram"`
+}
+
+// HistogramBucket 是服务时间直方图的一个桶，统计 ≤ UpperMs 且大于上一个桶上界的样本数
+type HistogramBucket struct {
+	UpperMs float64 `json:"le_ms"`
+	Count   int64   `json:"count"`
+}
+
+// NewProfileLevel 根据一个并发级别的全部服务时间样本（毫秒）和测量时长汇总结果。
+// samples 会被原地排序。
+func NewProfileLevel(concurrency int, samples []float64, elapsed time.Duration) ProfileLevel {
+	level := ProfileLevel{
+		Concurrency: concurrency,
+		Requests:    int64(len(samples)),
+	}
+	if len(samples) == 0 || elapsed <= 0 {
+		return level
+	}
+
+	sort.Float64s(samples)
+
+	var sum float64
+	for _, s := range samples {
+		sum += s
+	}
+	level.Mean = sum / float64(len(samples))
+
+	var sq float64
+	for _, s := range samples {
+		sq += (s - level.Mean) * (s - level.Mean)
+	}
+	level.Stddev = math.Sqrt(sq / float64(len(samples)))
+	if level.Mean > 0 {
+		level.CV = level.Stddev / level.Mean
+		level.ServiceRate = 1000 / level.Mean
+	}
+
+	level.Min = samples[0]
+	level.Max = samples[len(samples)-1]
+	level.P50 = quantile(samples, 0.5)
+	level.P90 = quantile(samples, 0.9)
+	level.P95 = quantile(samples, 0.95)
+	level.P99 = quantile(samples, 0.99)
+	level.P999 = quantile(samples, 0.999)
+
+	level.Throughput = float64(len(samples)) / elapsed.Seconds()
+	level.PerCore = level.Throughput / float64(concurrency)
+	level.Histogram = histogram(samples)
+
+	return level
+}
+
+// NewProfile 由各并发级别的结果生成画像，levels 需按并发数升序排列且从 1 开始
+func NewProfile(kind string, params Params, dist DistConfig, levelDuration time.Duration, numCPU, gomaxprocs int, levels []ProfileLevel) *Profile {
+	p := &Profile{
+		Kind:       kind,
+		Params:     params,
+		Dist:       dist,
+		CreatedAt:  time.Now(),
+		NumCPU:     numCPU,
+		GOMAXPROCS: gomaxprocs,
+		LevelSecs:  levelDuration.Seconds(),
+		Levels:     levels,
+	}
+	if len(levels) == 0 {
+		return p
+	}
+
+	base := levels[0].Throughput
+	p.ServiceRate = levels[0].ServiceRate
+	for i := range p.Levels {
+		l := &p.Levels[i]
+		if base > 0 {
+			l.ScalingEfficiency = l.Throughput / (base * float64(l.Concurrency))
+		}
+		if l.Throughput > p.PeakThroughput {
+			p.PeakThroughput = l.Throughput
+			p.PeakConcurrency = l.Concurrency
+		}
+	}
+	return p
+}
+
+// LoadProfile 从 JSON 文件读取画像
+func LoadProfile(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile: %w", err)
+	}
+
+	var p Profile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode profile: %w", err)
+	}
+	if len(p.Levels) == 0 {
+		return nil, fmt.Errorf("profile %s has no levels", path)
+	}
+	return &p, nil
+}
+
+// Capacity 返回在 workers 个并发工作者下的预期最大吞吐（请求/秒）。
+// workers <= 0 表示不限制并发，返回峰值吞吐；超出测量范围时使用最高并发级别的值。
+func (p *Profile) Capacity(workers int) float64 {
+	if workers <= 0 {
+		return p.PeakThroughput
+	}
+	for _, l := range p.Levels {
+		if l.Concurrency == workers {
+			return l.Throughput
+		}
+	}
+	return p.Levels[len(p.Levels)-1].Throughput
+}
+
+// SuggestQPSRange 返回覆盖 10%~90% 利用率、共 steps 个点的 QPS 扫描范围。
+// capacity 为整个目标集群的容量（单机容量乘以主机数）。结果被限制在 [1, maxQPS] 内，
+// 容量超出 maxQPS 时只扫描到 maxQPS；maxQPS <= 0 表示不限制上界。
+func SuggestQPSRange(capacity float64, steps, maxQPS int) (qpsMin, qpsMax, qpsStep int) {
+	if steps < 2 {
+		steps = 2
+	}
+	limit := func(qps float64) int {
+		if maxQPS > 0 {
+			qps = math.Min(qps, float64(maxQPS))
+		}
+		return int(math.Max(1, qps))
+	}
+	qpsMin = limit(math.Round(capacity * suggestMinUtilization))
+	qpsMax = max(qpsMin, limit(math.Round(capacity*suggestMaxUtilization)))
+	qpsStep = max((qpsMax-qpsMin)/(steps-1), 1)
+	return qpsMin, qpsMax, qpsStep
+}
+
+// quantile 返回已排序样本的 q 分位数（线性插值）
+func quantile(sorted []float64, q float64) float64 {
+	idx := q * float64(len(sorted)-1)
+	lo := int(math.Floor(idx))
+	hi := int(math.Ceil(idx))
+	if lo == hi {
+		return sorted[lo]
+	}
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(idx-float64(lo))
+}
+
+// histogram 以 2 的幂为桶上界（从 1µs 开始）统计已排序样本，省略空桶
+func histogram(sorted []float64) []HistogramBucket {
+	var buckets []HistogramBucket
+	upper := 0.001
+	i := 0
+	for i < len(sorted) {
+		var count int64
+		for i < len(sorted) && sorted[i] <= upper {
+			count++
+			i++
+		}
+		if count > 0 {
+			buckets = append(buckets, HistogramBucket{UpperMs: upper, Count: count})
+		}
+		upper *= 2
+	}
+	return buckets
+}
@@ -0,0 +1,448 @@
+package calculator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultKind 是未指定负载类型时使用的负载
+const DefaultKind = "gcd"
+
+// MaxIterations 是单个请求允许的最大迭代次数
+const MaxIterations = 1_000_000
+
+var (
+	// ErrUnknownWorkload 表示请求的负载类型未注册
+	ErrUnknownWorkload = errors.New("unknown workload")
+	// ErrInvalidParams 表示请求的负载参数超出允许范围
+	ErrInvalidParams = errors.New("invalid workload params")
+)
+
+// Params 描述一次负载的规模
+type Params struct {
+	// Size 负载规模，单位由负载决定：
+	// gcd 为操作数位数，sha256 为每轮输入字节数，matrix 为矩阵阶数，
+	// stream 为数组元素个数，pointer-chase 为环的节点数，sleep 为毫秒数
+	Size int `json:"size"`
+	// Iterations 每次请求重复执行的次数（pointer-chase 为跳转次数）
+	Iterations int `json:"iterations"`
+	// Fraction 在 Iterations 轮之后再执行的一轮的比例，取值 [0, 1)。
+	// 由服务时间分布产生，使缩放后的工作量是连续的而不是整数轮
+	Fraction float64 `json:"fraction,omitempty"`
+}
+
+// Result 是一次负载执行的结果
+type Result struct {
+	Kind   string `json:"kind"`
+	Params Params `json:"params"` // 实际生效的参数
+	Value  string `json:"value"`  // 结果摘要
+}
+
+// Workload 表示一种可插拔的计算负载
+type Workload interface {
+	// Name 返回负载名称，用于在请求中选择负载
+	Name() string
+	// Defaults 返回未指定参数时使用的规模
+	Defaults() Params
+	// MaxSize 返回允许的最大 Size，防止单个请求耗尽内存
+	MaxSize() int
+	// Work 返回参数 p 对应的总工作量，单位由负载决定（如 sha256 为哈希的字节数）
+	Work(p Params) int64
+	// MaxWork 返回单个请求允许的最大工作量，防止单个请求长时间占用工作者
+	MaxWork() int64
+	// Run 按给定规模执行一次负载，返回结果摘要（防止计算被编译器优化掉）
+	Run(p Params) string
+}
+
+// resolveParams 用负载默认值补全 p 中为零的字段并检查取值范围
+func resolveParams(w Workload, p Params) (Params, error) {
+	defaults := w.Defaults()
+	if p.Size == 0 {
+		p.Size = defaults.Size
+	}
+	if p.Iterations == 0 {
+		p.Iterations = defaults.Iterations
+	}
+
+	if p.Size < 1 || p.Size > w.MaxSize() {
+		return p, fmt.Errorf("%w: %s size must be in [1, %d], got %d", ErrInvalidParams, w.Name(), w.MaxSize(), p.Size)
+	}
+	if p.Iterations < 1 || p.Iterations > MaxIterations {
+		return p, fmt.Errorf("%w: iterations must be in [1, %d], got %d", ErrInvalidParams, MaxIterations, p.Iterations)
+	}
+	if work := w.Work(p); work > w.MaxWork() {
+		return p, fmt.Errorf("%w: %s work %d (size %d × iterations %d) exceeds the limit %d",
+			ErrInvalidParams, w.Name(), work, p.Size, p.Iterations, w.MaxWork())
+	}
+	return p, nil
+}
+
+const workloadDataSeed = 42
+
+func builtinWorkloads(c *Calculator) []Workload {
+	return []Workload{
+		gcdWorkload{c: c},
+		hashWorkload{},
+		matrixWorkload{},
+		streamWorkload{},
+		chaseWorkload{},
+		sleepWorkload{},
+	}
+}
+
+// gcdWorkload 大整数 GCD，CPU 密集型
+type gcdWorkload struct {
+	c *Calculator
+}
+
+func (gcdWorkload) Name() string { return "gcd" }
+
+func (w gcdWorkload) Defaults() Params { return Params{Size: w.c.fixedDigits, Iterations: 5} }
+
+func (gcdWorkload) MaxSize() int { return 100_000 }
+
+// 欧几里得算法的开销约与位数的平方成正比
+func (gcdWorkload) Work(p Params) int64 { return int64(p.Size) * int64(p.Size) * int64(p.Iterations) }
+
+func (gcdWorkload) MaxWork() int64 { return 20_000_000_000 }
+
+func (w gcdWorkload) Run(p Params) string {
+	// 默认规模直接使用预定义的固定操作数，其他规模使用按位数生成并缓存的操作数
+	a, b := w.c.fixedA, w.c.fixedB
+	if p.Size != w.c.fixedDigits {
+		ops := gcdOperands.get(p.Size)
+		a, b = ops[0], ops[1]
+	}
+	result := gcd(a, b, p.Iterations)
+	if p.Fraction > 0 {
+		gcdSteps(a, b, p.Fraction)
+	}
+	return result.String()
+}
+
+// hashWorkload 链式 SHA-256 哈希，CPU 密集型且几乎不访问内存
+type hashWorkload struct{}
+
+func (hashWorkload) Name() string { return "sha256" }
+
+func (hashWorkload) Defaults() Params { return Params{Size: 64 << 10, Iterations: 64} }
+
+func (hashWorkload) MaxSize() int { return 16 << 20 }
+
+func (hashWorkload) Work(p Params) int64 { return int64(p.Size) * int64(p.Iterations) }
+
+// 约 16GiB，常见 CPU 上约 10 秒
+func (hashWorkload) MaxWork() int64 { return 16 << 30 }
+
+func (hashWorkload) Run(p Params) string {
+	buf := hashBuffers.get(p.Size)
+	h := sha256.New()
+	var sum [sha256.Size]byte
+	for i := 0; i < p.Iterations; i++ {
+		h.Reset()
+		h.Write(sum[:])
+		h.Write(buf)
+		h.Sum(sum[:0])
+	}
+	if p.Fraction > 0 {
+		h.Reset()
+		h.Write(sum[:])
+		h.Write(buf[:int(p.Fraction*float64(len(buf)))])
+		h.Sum(sum[:0])
+	}
+	return hex.EncodeToString(sum[:])
+}
+
+// matrixWorkload 稠密矩阵乘法，计算密集且对缓存友好
+type matrixWorkload struct{}
+
+func (matrixWorkload) Name() string { return "matrix" }
+
+func (matrixWorkload) Defaults() Params { return Params{Size: 128, Iterations: 1} }
+
+func (matrixWorkload) MaxSize() int { return 1024 }
+
+// 朴素矩阵乘法每轮 n³ 次乘加
+func (matrixWorkload) Work(p Params) int64 {
+	n := int64(p.Size)
+	return n * n * n * int64(p.Iterations)
+}
+
+func (matrixWorkload) MaxWork() int64 { return 20_000_000_000 }
+
+func (matrixWorkload) Run(p Params) string {
+	m := matrices.get(p.Size)
+	n := p.Size
+	out := make([]float64, n*n)
+
+	var trace float64
+	for it := 0; it < p.Iterations; it++ {
+		trace += multiplyRows(m, out, n, n)
+	}
+	// 不完整的一轮只计算前若干行
+	if p.Fraction > 0 {
+		trace += multiplyRows(m, out, n, int(p.Fraction*float64(n)))
+	}
+	return strconv.FormatFloat(trace, 'g', -1, 64)
+}
+
+// multiplyRows 计算 a·b 的前 rows 行并返回这些行中对角线元素之和
+func multiplyRows(m matrixPair, out []float64, n, rows int) float64 {
+	clear(out)
+	for i := 0; i < rows; i++ {
+		row := out[i*n : (i+1)*n]
+		for k := 0; k < n; k++ {
+			aik := m.a[i*n+k]
+			bk := m.b[k*n : (k+1)*n]
+			for j := range row {
+				row[j] += aik * bk[j]
+			}
+		}
+	}
+
+	var trace float64
+	for i := 0; i < rows; i++ {
+		trace += out[i*n+i]
+	}
+	return trace
+}
+
+// streamWorkload STREAM triad（a = b + s*c），受内存带宽限制
+type streamWorkload struct{}
+
+func (streamWorkload) Name() string { return "stream" }
+
+// 默认每个数组 2M 个 float64（16MiB），超出常见 L3 容量
+func (streamWorkload) Defaults() Params { return Params{Size: 2 << 20, Iterations: 1} }
+
+// 每个数组最多 8M 个 float64（64MiB）
+func (streamWorkload) MaxSize() int { return 8 << 20 }
+
+func (streamWorkload) Work(p Params) int64 { return int64(p.Size) * int64(p.Iterations) }
+
+func (streamWorkload) MaxWork() int64 { return 8 << 30 }
+
+func (streamWorkload) Run(p Params) string {
+	arrays := streamArrays.get(p.Size)
+	ptr := arrays.dst.Get().(*[]float64)
+	defer arrays.dst.Put(ptr)
+	a := *ptr
+
+	const scalar = 3.0
+	triad := func(n int) {
+		for i := range a[:n] {
+			a[i] = arrays.b[i] + scalar*arrays.c[i]
+		}
+	}
+	for it := 0; it < p.Iterations; it++ {
+		triad(len(a))
+	}
+	if p.Fraction > 0 {
+		triad(int(p.Fraction * float64(len(a))))
+	}
+	return strconv.FormatFloat(a[len(a)-1], 'g', -1, 64)
+}
+
+// chaseWorkload 在随机单环上做指针追逐，每一跳几乎都是缓存未命中
+type chaseWorkload struct{}
+
+func (chaseWorkload) Name() string { return "pointer-chase" }
+
+// 默认 4M 个节点（16MiB）的随机环，每次请求跳转 64K 次
+func (chaseWorkload) Defaults() Params { return Params{Size: 4 << 20, Iterations: 1 << 16} }
+
+// 最多 16M 个节点（64MiB）
+func (chaseWorkload) MaxSize() int { return 16 << 20 }
+
+// 开销只取决于跳转次数，与环的大小无关
+func (chaseWorkload) Work(p Params) int64 { return int64(p.Iterations) }
+
+func (chaseWorkload) MaxWork() int64 { return MaxIterations }
+
+// 一轮只是一跳，Fraction 可以忽略
+func (chaseWorkload) Run(p Params) string {
+	next := chaseRings.get(p.Size)
+	var pos int32
+	for i := 0; i < p.Iterations; i++ {
+		pos = next[pos]
+	}
+	return strconv.Itoa(int(pos))
+}
+
+// sleepWorkload 纯等待，不消耗 CPU，用于对比非 CPU 受限的服务
+type sleepWorkload struct{}
+
+func (sleepWorkload) Name() string { return "sleep" }
+
+func (sleepWorkload) Defaults() Params { return Params{Size: 10, Iterations: 1} }
+
+func (sleepWorkload) MaxSize() int { return 60_000 }
+
+// 总等待时间（毫秒），和单次等待一样不超过 60 秒
+func (sleepWorkload) Work(p Params) int64 { return int64(p.Size) * int64(p.Iterations) }
+
+func (sleepWorkload) MaxWork() int64 { return 60_000 }
+
+func (sleepWorkload) Run(p Params) string {
+	d := time.Duration(float64(time.Duration(p.Size)*time.Millisecond) * (float64(p.Iterations) + p.Fraction))
+	time.Sleep(d)
+	return d.String()
+}
+
+// maxCachedSizes 限制每种共享数据缓存的规模种类数，超出后按需生成不再缓存
+const maxCachedSizes = 16
+
+// maxCachedBytes 限制所有共享数据缓存的总字节数，超出后按需生成不再缓存
+const maxCachedBytes = 512 << 20
+
+// cacheBudget 记录所有 sizeCache 已占用的字节数
+var cacheBudget struct {
+	mu   sync.Mutex
+	used int64
+}
+
+// reserveCacheBytes 在总字节数不超过 maxCachedBytes 时占用 n 字节并返回 true
+func reserveCacheBytes(n int64) bool {
+	cacheBudget.mu.Lock()
+	defer cacheBudget.mu.Unlock()
+
+	if cacheBudget.used+n > maxCachedBytes {
+		return false
+	}
+	cacheBudget.used += n
+	return true
+}
+
+// sizeEntry 是一个规模的缓存项，once 保证并发请求只生成一次
+type sizeEntry[T any] struct {
+	once  sync.Once
+	value T
+}
+
+// sizeCache 按规模缓存只读的共享数据，首次使用时生成，之后可被并发请求共享。
+// 生成在锁外进行，生成大规模数据时不会阻塞其他规模的请求。
+type sizeCache[T any] struct {
+	mu    sync.Mutex
+	items map[int]*sizeEntry[T]
+	build func(size int) T
+	bytes func(size int) int64 // 估算生成的数据占用的字节数
+}
+
+func newSizeCache[T any](build func(size int) T, bytes func(size int) int64) *sizeCache[T] {
+	return &sizeCache[T]{
+		items: make(map[int]*sizeEntry[T]),
+		build: build,
+		bytes: bytes,
+	}
+}
+
+func (c *sizeCache[T]) get(size int) T {
+	c.mu.Lock()
+	e, ok := c.items[size]
+	if !ok && len(c.items) < maxCachedSizes && reserveCacheBytes(c.bytes(size)) {
+		e = &sizeEntry[T]{}
+		c.items[size] = e
+		ok = true
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return c.build(size)
+	}
+	e.once.Do(func() { e.value = c.build(size) })
+	return e.value
+}
+
+type matrixPair struct {
+	a, b []float64
+}
+
+type streamSet struct {
+	b, c []float64
+	dst  *sync.Pool // 每个请求独占的目标数组
+}
+
+var (
+	// gcdOperands 按位数生成的两个随机操作数
+	gcdOperands = newSizeCache(func(digits int) [2]*big.Int {
+		rng := rand.New(rand.NewSource(workloadDataSeed + int64(digits)))
+		return [2]*big.Int{randomDigits(rng, digits), randomDigits(rng, digits)}
+	}, func(digits int) int64 {
+		// 每个十进制位约 0.415 字节
+		return int64(digits)
+	})
+
+	hashBuffers = newSizeCache(func(size int) []byte {
+		rng := rand.New(rand.NewSource(workloadDataSeed))
+		buf := make([]byte, size)
+		rng.Read(buf)
+		return buf
+	}, func(size int) int64 {
+		return int64(size)
+	})
+
+	matrices = newSizeCache(func(n int) matrixPair {
+		rng := rand.New(rand.NewSource(workloadDataSeed))
+		m := matrixPair{a: make([]float64, n*n), b: make([]float64, n*n)}
+		for i := range m.a {
+			m.a[i] = rng.Float64()
+			m.b[i] = rng.Float64()
+		}
+		return m
+	}, func(n int) int64 {
+		return 2 * 8 * int64(n) * int64(n)
+	})
+
+	streamArrays = newSizeCache(func(n int) *streamSet {
+		s := &streamSet{
+			b: make([]float64, n),
+			c: make([]float64, n),
+			dst: &sync.Pool{New: func() any {
+				buf := make([]float64, n)
+				return &buf
+			}},
+		}
+		for i := range s.b {
+			s.b[i] = float64(i)
+			s.c[i] = float64(n - i)
+		}
+		return s
+	}, func(n int) int64 {
+		// 目标数组由请求独占，不计入缓存
+		return 2 * 8 * int64(n)
+	})
+
+	// chaseRings 使用 Sattolo 算法生成覆盖所有节点的随机单环
+	chaseRings = newSizeCache(func(n int) []int32 {
+		rng := rand.New(rand.NewSource(workloadDataSeed))
+		ring := make([]int32, n)
+		for i := range ring {
+			ring[i] = int32(i)
+		}
+		for i := len(ring) - 1; i > 0; i-- {
+			j := rng.Intn(i)
+			ring[i], ring[j] = ring[j], ring[i]
+		}
+		return ring
+	}, func(n int) int64 {
+		return 4 * int64(n)
+	})
+)
+
+// randomDigits 生成一个恰好 digits 位的随机十进制整数
+func randomDigits(rng *rand.Rand, digits int) *big.Int {
+	buf := make([]byte, digits)
+	buf[0] = byte('1' + rng.Intn(9))
+	for i := 1; i < digits; i++ {
+		buf[i] = byte('0' + rng.Intn(10))
+	}
+	n, _ := new(big.Int).SetString(string(buf), 10)
+	return n
+}
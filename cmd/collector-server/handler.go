@@ -1,6 +1,9 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
 	"time"
 
@@ -11,6 +14,16 @@ import (
 	"github.com/rs/zerolog"
 )
 
+const (
+	// defaultListLimit and maxListLimit bound the page size of the experiment list
+	defaultListLimit = 50
+	maxListLimit     = 100
+	// defaultLatestPoints is how many points the latest endpoint returns without n
+	defaultLatestPoints = 60
+	// streamKeepAlive is how often an idle event stream sends a comment so proxies keep it open
+	streamKeepAlive = 15 * time.Second
+)
+
 // APIHandler implements the OpenAPI generated ServerInterface
 type APIHandler struct {
 	service *collector.Service
@@ -21,8 +34,13 @@ type APIHandler struct {
 // GetServiceConfig implements getting the service configuration
 func (h *APIHandler) GetServiceConfig(c *gin.Context) {
 	response := generated.ServiceConfig{
-		CollectionInterval: h.config.CollectionInterval,
+		CollectionInterval: h.config.CollectionIntervalMs,
 		CalculatorProcess:  h.config.CalculatorProcess,
+		CalculatorPort:     h.config.CalculatorPort,
+		ProcRoot:           h.config.ProcRoot,
+		CgroupPath:         h.config.CgroupPath,
+		NetworkInclude:     h.config.NetworkInclude,
+		NetworkExclude:     h.config.NetworkExclude,
 	}
 	c.JSON(http.StatusOK, response)
 }
@@ -49,18 +67,65 @@ func (h *APIHandler) HealthCheck(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// ListExperiments implements getting list of experiments
+// ListExperiments implements listing the stored and running experiments with filtering and pagination
 func (h *APIHandler) ListExperiments(c *gin.Context, params generated.ListExperimentsParams) {
-	// Note: Current Service design only supports one experiment at a time
-	// This is a simplified implementation that returns empty list
-	// In the future, we can add support for storing experiment history
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+	if limit < 1 || limit > maxListLimit || params.Offset < 0 {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error:     "invalid_request",
+			Message:   fmt.Sprintf("limit must be between 1 and %d and offset must not be negative", maxListLimit),
+			Timestamp: time.Now(),
+		})
+		return
+	}
 
-	response := generated.ExperimentListResponse{
-		Experiments: []generated.ExperimentSummary{},
-		Total:       0,
-		HasMore:     false,
+	status := string(params.Status)
+	if status == string(generated.ListExperimentsParamsStatusAll) {
+		status = ""
 	}
 
+	summaries, total, err := h.service.ListExperimentSummaries(collector.ListOptions{
+		Status:        status,
+		StartedAfter:  params.StartedAfter,
+		StartedBefore: params.StartedBefore,
+		SortBy:        string(params.SortBy),
+		Descending:    params.SortOrder != generated.Asc,
+		Offset:        params.Offset,
+		Limit:         limit,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, generated.ErrorResponse{
+			Error:     "internal_error",
+			Message:   err.Error(),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+
+	experiments := make([]generated.ExperimentSummary, len(summaries))
+	for i, summary := range summaries {
+		experiments[i] = generated.ExperimentSummary{
+			ExperimentId:        summary.ID,
+			Status:              generated.ExperimentSummaryStatus(summary.Status),
+			IsActive:            summary.Status == collector.StatusRunning,
+			StartTime:           summary.StartTime,
+			Duration:            int(math.Round(summary.Duration)),
+			DataPointsCollected: summary.DataPoints,
+			CpuMean:             float32(summary.CPUMean),
+		}
+		if !summary.EndTime.IsZero() {
+			experiments[i].EndTime = &summary.EndTime
+		}
+	}
+
+	response := generated.ExperimentListResponse{
+		Experiments: experiments,
+		Total:       total,
+		HasMore:     params.Offset+len(experiments) < total,
+	}
 	c.JSON(http.StatusOK, response)
 }
 
@@ -167,11 +232,17 @@ func (h *APIHandler) GetExperimentData(c *gin.Context, experimentId string) {
 		return
 	}
 
+	// Data that recorded no interval at all is assumed to use the current one
+	collectionInterval := data.Config.CollectionIntervalMs
+	if collectionInterval == 0 {
+		collectionInterval = h.config.CollectionIntervalMs
+	}
+
 	// Convert MetricsData to ExperimentData
 	result := generated.ExperimentData{
 		ExperimentId:       experimentId,
 		StartTime:          data.StartTime,
-		CollectionInterval: h.config.CollectionInterval * 1000, // Convert to milliseconds
+		CollectionInterval: collectionInterval,
 		Metrics:            make([]generated.MetricDataPoint, 0, len(data.Metrics)),
 	}
 
@@ -182,23 +253,333 @@ func (h *APIHandler) GetExperimentData(c *gin.Context, experimentId string) {
 
 	// Convert metrics
 	for _, metric := range data.Metrics {
-		dataPoint := generated.MetricDataPoint{
-			Timestamp: metric.Timestamp,
-			SystemMetrics: generated.SystemMetrics{
-				CpuUsagePercent:          float32(metric.CPUUsagePercent),
-				MemoryUsageBytes:         metric.MemoryUsageBytes,
-				MemoryUsagePercent:       float32(metric.MemoryUsagePercent),
-				CalculatorServiceHealthy: metric.CalculatorServiceHealthy,
-				NetworkIOBytes: generated.NetworkIO{
-					BytesReceived:   metric.NetworkIOBytes.BytesReceived,
-					BytesSent:       metric.NetworkIOBytes.BytesSent,
-					PacketsReceived: metric.NetworkIOBytes.PacketsReceived,
-					PacketsSent:     metric.NetworkIOBytes.PacketsSent,
-				},
+		result.Metrics = append(result.Metrics, dataPointToAPI(metric))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// GetLatestExperimentData implements polling the latest data points of the current experiment
+func (h *APIHandler) GetLatestExperimentData(c *gin.Context, experimentId string, params generated.GetLatestExperimentDataParams) {
+	n := params.N
+	if n == 0 {
+		n = defaultLatestPoints
+	}
+	if n < 1 || n > collector.LiveBufferSize {
+		c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+			Error:     "invalid_request",
+			Message:   fmt.Sprintf("n must be between 1 and %d", collector.LiveBufferSize),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+
+	points, running, ok := h.service.LatestDataPoints(experimentId, n)
+	if !ok {
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error:     "experiment_not_found",
+			Message:   "not the current experiment, its data is available once saved",
+			Timestamp: time.Now(),
+		})
+		return
+	}
+
+	response := generated.LiveDataResponse{
+		ExperimentId: experimentId,
+		Running:      running,
+		Metrics:      make([]generated.MetricDataPoint, 0, len(points)),
+	}
+	if len(points) > 0 {
+		response.FirstSeq = points[0].Seq
+	}
+	for _, point := range points {
+		response.Metrics = append(response.Metrics, dataPointToAPI(point.Point))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// StreamExperimentData implements the Server-Sent Events stream of the running experiment
+func (h *APIHandler) StreamExperimentData(c *gin.Context, experimentId string) {
+	points, cancel, ok := h.service.SubscribeDataPoints(experimentId)
+	if !ok {
+		c.JSON(http.StatusNotFound, generated.ErrorResponse{
+			Error:     "experiment_not_running",
+			Message:   fmt.Sprintf("experiment %s is not running", experimentId),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+	defer cancel()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // keep reverse proxies from buffering the stream
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+
+		case <-keepAlive.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			c.Writer.Flush()
+
+		case point, open := <-points:
+			if !open {
+				fmt.Fprintf(c.Writer, "event: end\ndata: {\"experimentId\":%q}\n\n", experimentId)
+				c.Writer.Flush()
+				return
+			}
+			data, err := json.Marshal(dataPointToAPI(point.Point))
+			if err != nil {
+				h.logger.Error().Err(err).Msg("Failed to encode data point")
+				continue
+			}
+			fmt.Fprintf(c.Writer, "id: %d\nevent: metric\ndata: %s\n\n", point.Seq, data)
+			c.Writer.Flush()
+		}
+	}
+}
+
+// dataPointToAPI converts one collected data point
+func dataPointToAPI(metric collector.MetricDataPoint) generated.MetricDataPoint {
+	return generated.MetricDataPoint{
+		Timestamp:      metric.Timestamp,
+		ProcessMetrics: processMetricsToAPI(metric.Process),
+		CgroupMetrics:  cgroupMetricsToAPI(metric.Cgroup),
+		TcpMetrics:     tcpMetricsToAPI(metric.TCP),
+		SystemMetrics: generated.SystemMetrics{
+			CpuUsagePercent:          float32(metric.CPUUsagePercent),
+			PerCpuUsagePercent:       float32Slice(metric.PerCPUUsagePercent),
+			MaxCpuUsagePercent:       float32(metric.MaxCPUUsagePercent),
+			CpuBreakdown:             cpuBreakdownToAPI(metric.CPUBreakdown),
+			Scheduler:                schedulerMetricsToAPI(metric.Scheduler),
+			MemoryUsageBytes:         metric.MemoryUsageBytes,
+			MemoryUsagePercent:       float32(metric.MemoryUsagePercent),
+			CalculatorServiceHealthy: metric.CalculatorServiceHealthy,
+			NetworkIOBytes: generated.NetworkIO{
+				BytesReceived:   metric.NetworkIOBytes.BytesReceived,
+				BytesSent:       metric.NetworkIOBytes.BytesSent,
+				PacketsReceived: metric.NetworkIOBytes.PacketsReceived,
+				PacketsSent:     metric.NetworkIOBytes.PacketsSent,
 			},
+			Interfaces:   interfacesToAPI(metric.Interfaces),
+			Disks:        disksToAPI(metric.Disks),
+			MemoryDetail: memoryDetailToAPI(metric.Memory),
+		},
+	}
+}
+
+// processMetricsToAPI converts the calculator process metrics, nil stays nil
+func processMetricsToAPI(p *collector.ProcessMetrics) *generated.ProcessMetrics {
+	if p == nil {
+		return nil
+	}
+	return &generated.ProcessMetrics{
+		Pids:                         p.PIDs,
+		CpuPercent:                   float32(p.CPUPercent),
+		HostCpuPercent:               float32(p.HostCPUPercent),
+		RssBytes:                     p.RSSBytes,
+		Threads:                      p.Threads,
+		OpenFds:                      p.OpenFDs,
+		VoluntaryCtxSwitchesPerSec:   float32(p.VoluntaryCtxSwitchesPerSec),
+		InvoluntaryCtxSwitchesPerSec: float32(p.InvoluntaryCtxSwitchesPerSec),
+		ReadBytesPerSec:              float32(p.ReadBytesPerSec),
+		WriteBytesPerSec:             float32(p.WriteBytesPerSec),
+		Restarted:                    p.Restarted,
+		Restarts:                     p.Restarts,
+	}
+}
+
+// interfacesToAPI converts the per-interface network I/O
+func interfacesToAPI(interfaces []collector.InterfaceIO) []generated.InterfaceIO {
+	if interfaces == nil {
+		return nil
+	}
+	result := make([]generated.InterfaceIO, len(interfaces))
+	for i, iface := range interfaces {
+		result[i] = generated.InterfaceIO{
+			Name:            iface.Name,
+			BytesReceived:   iface.BytesReceived,
+			BytesSent:       iface.BytesSent,
+			PacketsReceived: iface.PacketsReceived,
+			PacketsSent:     iface.PacketsSent,
+			ErrorsInPerSec:  iface.ErrorsInPerSec,
+			ErrorsOutPerSec: iface.ErrorsOutPerSec,
+			DropsInPerSec:   iface.DropsInPerSec,
+			DropsOutPerSec:  iface.DropsOutPerSec,
 		}
-		result.Metrics = append(result.Metrics, dataPoint)
 	}
+	return result
+}
 
-	c.JSON(http.StatusOK, result)
+// disksToAPI converts the per-device disk I/O, nil stays nil
+func disksToAPI(disks []collector.DiskIO) []generated.DiskIO {
+	if disks == nil {
+		return nil
+	}
+	result := make([]generated.DiskIO, len(disks))
+	for i, disk := range disks {
+		result[i] = generated.DiskIO{
+			Name:             disk.Name,
+			ReadBytesPerSec:  disk.ReadBytesPerSec,
+			WriteBytesPerSec: disk.WriteBytesPerSec,
+			ReadIops:         float32(disk.ReadIOPS),
+			WriteIops:        float32(disk.WriteIOPS),
+			BusyPercent:      float32(disk.BusyPercent),
+		}
+	}
+	return result
+}
+
+// memoryDetailToAPI converts the vmstat memory detail, nil stays nil
+func memoryDetailToAPI(m *collector.MemoryDetail) *generated.MemoryDetail {
+	if m == nil {
+		return nil
+	}
+	return &generated.MemoryDetail{
+		PageCacheBytes:    m.PageCacheBytes,
+		DirtyBytes:        m.DirtyBytes,
+		WritebackBytes:    m.WritebackBytes,
+		SwapInPerSec:      float32(m.SwapInPerSec),
+		SwapOutPerSec:     float32(m.SwapOutPerSec),
+		MajorFaultsPerSec: float32(m.MajorFaultsPerSec),
+		MinorFaultsPerSec: float32(m.MinorFaultsPerSec),
+	}
+}
+
+// cgroupMetricsToAPI converts the cgroup metrics, nil stays nil
+func cgroupMetricsToAPI(c *collector.CgroupMetrics) *generated.CgroupMetrics {
+	if c == nil {
+		return nil
+	}
+	result := &generated.CgroupMetrics{
+		Path:                    c.Path,
+		CpuUsagePercent:         float32(c.CPUUsagePercent),
+		CpuLimitCores:           float32(c.CPULimitCores),
+		NrPeriods:               int64(c.NrPeriods),
+		NrThrottled:             int64(c.NrThrottled),
+		ThrottledUsec:           int64(c.ThrottledUsec),
+		ThrottledPeriodsPercent: float32(c.ThrottledPeriodsPercent),
+		MemoryCurrentBytes:      c.MemoryCurrentBytes,
+		MemoryMaxBytes:          c.MemoryMaxBytes,
+	}
+	if e := c.MemoryEvents; e != nil {
+		result.MemoryEvents = &generated.MemoryEvents{
+			Low:     int64(e.Low),
+			High:    int64(e.High),
+			Max:     int64(e.Max),
+			Oom:     int64(e.OOM),
+			OomKill: int64(e.OOMKill),
+		}
+	}
+	return result
+}
+
+// tcpMetricsToAPI converts the kernel TCP metrics, nil stays nil
+func tcpMetricsToAPI(t *collector.TCPMetrics) *generated.TCPMetrics {
+	if t == nil {
+		return nil
+	}
+	result := &generated.TCPMetrics{
+		PortStates:    t.PortStates,
+		ListenBacklog: t.ListenBacklog,
+	}
+	if c := t.Counters; c != nil {
+		result.Counters = &generated.TCPCounters{
+			ActiveOpens:     int64(c.ActiveOpens),
+			PassiveOpens:    int64(c.PassiveOpens),
+			AttemptFails:    int64(c.AttemptFails),
+			EstabResets:     int64(c.EstabResets),
+			InSegs:          int64(c.InSegs),
+			OutSegs:         int64(c.OutSegs),
+			RetransSegs:     int64(c.RetransSegs),
+			InErrs:          int64(c.InErrs),
+			OutRsts:         int64(c.OutRsts),
+			ListenOverflows: int64(c.ListenOverflows),
+			ListenDrops:     int64(c.ListenDrops),
+			TcpTimeouts:     int64(c.TCPTimeouts),
+		}
+	}
+	return result
+}
+
+// schedulerMetricsToAPI converts the scheduler pressure metrics, nil stays nil
+func schedulerMetricsToAPI(s *collector.SchedulerMetrics) *generated.SchedulerMetrics {
+	if s == nil {
+		return nil
+	}
+	result := &generated.SchedulerMetrics{
+		Load1:          float32(s.Load1),
+		Load5:          float32(s.Load5),
+		Load15:         float32(s.Load15),
+		ProcsRunning:   s.ProcsRunning,
+		ProcsBlocked:   s.ProcsBlocked,
+		CpuPressure:    pressureToAPI(s.CPUPressure),
+		MemoryPressure: pressureToAPI(s.MemoryPressure),
+		IoPressure:     pressureToAPI(s.IOPressure),
+	}
+	if s.RunQueueWait != nil {
+		result.RunQueueWait = &generated.RunQueueWait{
+			WaitingTasks:   float32(s.RunQueueWait.WaitingTasks),
+			PerTimesliceMs: float32(s.RunQueueWait.PerTimesliceMs),
+		}
+	}
+	return result
+}
+
+// pressureToAPI converts one PSI resource, nil stays nil
+func pressureToAPI(p *collector.Pressure) *generated.Pressure {
+	if p == nil {
+		return nil
+	}
+	stall := func(s collector.PressureStall) generated.PressureStall {
+		return generated.PressureStall{
+			Avg10:   float32(s.Avg10),
+			Avg60:   float32(s.Avg60),
+			Avg300:  float32(s.Avg300),
+			TotalUs: int64(s.TotalUs),
+		}
+	}
+	result := &generated.Pressure{Some: stall(p.Some)}
+	if p.Full != nil {
+		full := stall(*p.Full)
+		result.Full = &full
+	}
+	return result
+}
+
+// cpuBreakdownToAPI converts a collector CPU breakdown, nil stays nil
+func cpuBreakdownToAPI(b *collector.CPUBreakdown) *generated.CPUBreakdown {
+	if b == nil {
+		return nil
+	}
+	return &generated.CPUBreakdown{
+		User:    float32(b.User),
+		Nice:    float32(b.Nice),
+		System:  float32(b.System),
+		Iowait:  float32(b.Iowait),
+		Irq:     float32(b.Irq),
+		Softirq: float32(b.Softirq),
+		Steal:   float32(b.Steal),
+		Idle:    float32(b.Idle),
+	}
+}
+
+// float32Slice converts collector values to the float32 used by the generated API types
+func float32Slice(values []float64) []float32 {
+	if values == nil {
+		return nil
+	}
+	out := make([]float32, len(values))
+	for i, v := range values {
+		out[i] = float32(v)
+	}
+	return out
 }
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -21,6 +23,7 @@ const (
 	defaultPort               = "8080"
 	defaultCollectionInterval = "1"
 	defaultCalculatorProcess  = "cpusim-server"
+	defaultCalculatorPort     = "80"
 	defaultStoragePath        = "./data/collector"
 )
 
@@ -32,11 +35,24 @@ func main() {
 	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
 
 	// Create collector config from environment
-	collectionInterval, _ := strconv.Atoi(getEnv("COLLECTION_INTERVAL", defaultCollectionInterval))
+	collectionInterval, err := parseCollectionInterval(getEnv("COLLECTION_INTERVAL", defaultCollectionInterval))
+	if err != nil {
+		log.Fatalf("Invalid COLLECTION_INTERVAL: %v", err)
+	}
+	calculatorPort, err := strconv.Atoi(getEnv("CALCULATOR_PORT", defaultCalculatorPort))
+	if err != nil {
+		log.Fatalf("Invalid CALCULATOR_PORT: %v", err)
+	}
 
 	config := collector.Config{
-		CollectionInterval: collectionInterval,
-		CalculatorProcess:  getEnv("CALCULATOR_PROCESS", defaultCalculatorProcess),
+		CollectionIntervalMs: int(collectionInterval.Milliseconds()),
+		CalculatorProcess:    getEnv("CALCULATOR_PROCESS", defaultCalculatorProcess),
+		CalculatorPort:       calculatorPort,
+		ProcRoot:             getEnv("PROC_ROOT", collector.DefaultProcRoot),
+		CgroupPath:           os.Getenv("CGROUP_PATH"),
+		CgroupRoot:           getEnv("CGROUP_ROOT", collector.DefaultCgroupRoot),
+		NetworkInclude:       splitList(os.Getenv("NETWORK_INCLUDE")),
+		NetworkExclude:       splitList(os.Getenv("NETWORK_EXCLUDE")),
 	}
 
 	storagePath := getEnv("STORAGE_PATH", defaultStoragePath)
@@ -74,8 +90,18 @@ func main() {
 	// Start server in a goroutine
 	go func() {
 		log.Printf("Starting collector server on port %s", port)
-		log.Printf("Collection interval: %d seconds", config.CollectionInterval)
+		log.Printf("Collection interval: %v", config.Interval())
 		log.Printf("Calculator process: %s", config.CalculatorProcess)
+		if config.CalculatorPort > 0 {
+			log.Printf("Calculator port: %d", config.CalculatorPort)
+		}
+		log.Printf("Proc root: %s", config.ProcRoot)
+		if len(config.NetworkInclude) > 0 || len(config.NetworkExclude) > 0 {
+			log.Printf("Network interfaces: include %v, exclude %v", config.NetworkInclude, config.NetworkExclude)
+		}
+		if config.CgroupPath != "" {
+			log.Printf("Cgroup: %s (root %s)", config.CgroupPath, config.CgroupRoot)
+		}
 		log.Printf("Storage path: %s", storagePath)
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -106,6 +132,30 @@ func main() {
 	log.Println("Server exited")
 }
 
+// parseCollectionInterval parses COLLECTION_INTERVAL, either a Go duration ("100ms", "2s")
+// or a plain number of seconds ("1", "0.25") as accepted by earlier versions
+func parseCollectionInterval(value string) (time.Duration, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return d, nil
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is neither a duration nor a number of seconds", value)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// splitList splits a comma separated environment value, ignoring empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // getEnv gets an environment variable or returns a default value
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
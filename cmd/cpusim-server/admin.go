@@ -0,0 +1,257 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"cpusim/calculator"
+)
+
+// maxEvents bounds the in-memory admin event log, the oldest events are dropped first
+const maxEvents = 10000
+
+// maxStall is the longest stall that can be requested through the admin API
+const maxStall = time.Hour
+
+// Admin event types
+const (
+	eventStart    = "start"
+	eventWorkload = "workload"
+	eventFaults   = "faults"
+	eventStall    = "stall"
+	eventCache    = "cache"
+)
+
+// faultConfig describes the faults injected into calculate requests
+type faultConfig struct {
+	ErrorRate        float64 `json:"error_rate"`         // probability of answering with HTTP 500 without doing any work
+	LatencySpikeProb float64 `json:"latency_spike_prob"` // probability of adding a latency spike after the work
+	LatencySpikeMs   int     `json:"latency_spike_ms"`   // length of a latency spike in milliseconds
+}
+
+// validate checks that probabilities and durations are in range
+func (f faultConfig) validate() error {
+	if f.ErrorRate < 0 || f.ErrorRate > 1 {
+		return fmt.Errorf("error_rate must be in [0, 1], got %v", f.ErrorRate)
+	}
+	if f.LatencySpikeProb < 0 || f.LatencySpikeProb > 1 {
+		return fmt.Errorf("latency_spike_prob must be in [0, 1], got %v", f.LatencySpikeProb)
+	}
+	if f.LatencySpikeMs < 0 || time.Duration(f.LatencySpikeMs)*time.Millisecond > maxStall {
+		return fmt.Errorf("latency_spike_ms must be in [0, %d], got %d", maxStall.Milliseconds(), f.LatencySpikeMs)
+	}
+	return nil
+}
+
+// runtimeConfig is the part of the server configuration that the admin API can change while running
+type runtimeConfig struct {
+	Workload   string            `json:"workload"`
+	Params     calculator.Params `json:"params"` // 0 fields use the workload defaults
+	Faults     faultConfig       `json:"faults"`
+	StallUntil time.Time         `json:"stall_until,omitzero"` // calculate requests block until this time
+}
+
+// adminEvent is one timestamped configuration change
+type adminEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	Type      string    `json:"type"`
+	Detail    any       `json:"detail"`
+}
+
+// eventLog keeps the admin changes in memory so experiments can be aligned with them afterwards
+type eventLog struct {
+	mu     sync.Mutex
+	events []adminEvent
+}
+
+// record appends an event with the current time and logs it
+func (l *eventLog) record(eventType string, detail any) {
+	ev := adminEvent{Timestamp: time.Now(), Type: eventType, Detail: detail}
+
+	l.mu.Lock()
+	if len(l.events) >= maxEvents {
+		l.events = append(l.events[:0], l.events[1:]...)
+	}
+	l.events = append(l.events, ev)
+	l.mu.Unlock()
+
+	data, _ := json.Marshal(detail)
+	log.Printf("管理事件: %s %s", eventType, data)
+}
+
+// since returns a copy of the events recorded at or after t
+func (l *eventLog) since(t time.Time) []adminEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	events := make([]adminEvent, 0, len(l.events))
+	for _, ev := range l.events {
+		if !ev.Timestamp.Before(t) {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+// config returns a snapshot of the runtime configuration
+func (s *server) config() runtimeConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cfg
+}
+
+// registerAdmin adds the admin endpoints to mux
+func (s *server) registerAdmin(mux *http.ServeMux) {
+	mux.HandleFunc("GET /admin/config", s.adminConfigHandler)
+	mux.HandleFunc("PUT /admin/workload", s.adminWorkloadHandler)
+	mux.HandleFunc("PUT /admin/faults", s.adminFaultsHandler)
+	mux.HandleFunc("DELETE /admin/faults", s.adminClearFaultsHandler)
+	mux.HandleFunc("POST /admin/stall", s.adminStallHandler)
+	mux.HandleFunc("GET /admin/cache", s.adminCacheHandler)
+	mux.HandleFunc("PUT /admin/cache", s.adminSetCacheHandler)
+	mux.Handle("GET /admin/events", s.events)
+}
+
+// adminConfigHandler returns the current runtime configuration
+func (s *server) adminConfigHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.config())
+}
+
+// adminWorkloadHandler changes the default workload kind and params.
+// An empty kind keeps the current kind, zero params use the workload defaults.
+func (s *server) adminWorkloadHandler(w http.ResponseWriter, r *http.Request) {
+	var req CalculationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	kind := req.Kind
+	if kind == "" {
+		kind = s.cfg.Workload
+	}
+	params := calculator.Params{Size: req.Size, Iterations: req.Iterations}
+	if _, _, err := s.calc.Resolve(kind, params); err != nil {
+		s.mu.Unlock()
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.cfg.Workload = kind
+	s.cfg.Params = params
+	cfg := s.cfg
+	s.mu.Unlock()
+
+	s.events.record(eventWorkload, CalculationRequest{Kind: kind, Size: params.Size, Iterations: params.Iterations})
+	writeJSON(w, http.StatusOK, cfg)
+}
+
+// adminFaultsHandler replaces the injected fault configuration
+func (s *server) adminFaultsHandler(w http.ResponseWriter, r *http.Request) {
+	var faults faultConfig
+	if err := json.NewDecoder(r.Body).Decode(&faults); err != nil {
+		http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := faults.validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.setFaults(faults, false)
+	writeJSON(w, http.StatusOK, s.config())
+}
+
+// adminClearFaultsHandler removes all injected faults, including an ongoing stall
+func (s *server) adminClearFaultsHandler(w http.ResponseWriter, r *http.Request) {
+	s.setFaults(faultConfig{}, true)
+	writeJSON(w, http.StatusOK, s.config())
+}
+
+// setFaults updates the fault configuration and records the change,
+// ending an ongoing stall when clearStall is set
+func (s *server) setFaults(faults faultConfig, clearStall bool) {
+	s.mu.Lock()
+	s.cfg.Faults = faults
+	if clearStall {
+		s.cfg.StallUntil = time.Time{}
+	}
+	s.mu.Unlock()
+
+	s.events.record(eventFaults, faults)
+}
+
+// adminCacheHandler returns the cache emulation config and its hit/miss counters
+func (s *server) adminCacheHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.cache.Stats())
+}
+
+// adminSetCacheHandler replaces the cache emulation config, which also empties the LRU and
+// resets the counters so that every experiment starts cold
+func (s *server) adminSetCacheHandler(w http.ResponseWriter, r *http.Request) {
+	var cfg calculator.CacheConfig
+	if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+		http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.cache.Configure(cfg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.events.record(eventCache, cfg)
+	writeJSON(w, http.StatusOK, s.cache.Stats())
+}
+
+// stallRequest is the body of POST /admin/stall
+type stallRequest struct {
+	Seconds float64 `json:"seconds"`
+}
+
+// adminStallHandler makes calculate requests block for the given number of seconds from now,
+// holding their workers so that the queue builds up behind them
+func (s *server) adminStallHandler(w http.ResponseWriter, r *http.Request) {
+	var req stallRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	stall := time.Duration(req.Seconds * float64(time.Second))
+	if stall <= 0 || stall > maxStall {
+		http.Error(w, fmt.Sprintf("seconds must be in (0, %v], got %v", maxStall.Seconds(), req.Seconds), http.StatusBadRequest)
+		return
+	}
+
+	until := time.Now().Add(stall)
+	s.mu.Lock()
+	s.cfg.StallUntil = until
+	s.mu.Unlock()
+
+	s.events.record(eventStall, map[string]any{"seconds": req.Seconds, "until": until})
+	writeJSON(w, http.StatusOK, s.config())
+}
+
+// ServeHTTP returns the event log, optionally only the events since ?since=<RFC3339>
+func (l *eventLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var since time.Time
+	if v := r.URL.Query().Get("since"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			http.Error(w, "since参数格式错误（需要RFC3339）: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		since = t
+	}
+	writeJSON(w, http.StatusOK, l.since(since))
+}
+
+// writeJSON writes v as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
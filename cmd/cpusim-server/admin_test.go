@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"cpusim/internal/servertiming"
+)
+
+// newAdminMux serves the calculate and admin endpoints of s like server mode does
+func newAdminMux(s *server) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/calculate", s.pool.middleware(http.HandlerFunc(s.calculateHandler)))
+	s.registerAdmin(mux)
+	return mux
+}
+
+// do sends a request to mux and returns the recorded response
+func do(mux http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+	return rec
+}
+
+func TestAdminValidation(t *testing.T) {
+	mux := newAdminMux(newTestServer(t))
+
+	for _, tc := range []struct {
+		method, target, body string
+		want                 int
+	}{
+		{http.MethodPut, "/admin/faults", `{"error_rate":0.5,"latency_spike_prob":0.1,"latency_spike_ms":10}`, http.StatusOK},
+		{http.MethodPut, "/admin/faults", `{"error_rate":1.5}`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/faults", `{"latency_spike_prob":-0.1}`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/faults", `{"latency_spike_ms":3600001}`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/faults", `not json`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/workload", `{"kind":"sha256","size":1024}`, http.StatusOK},
+		{http.MethodPut, "/admin/workload", `{"kind":"nope"}`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/workload", `{"kind":"sleep","size":60000,"iterations":2}`, http.StatusBadRequest},
+		{http.MethodPost, "/admin/stall", `{"seconds":0}`, http.StatusBadRequest},
+		{http.MethodPost, "/admin/stall", `{"seconds":3601}`, http.StatusBadRequest},
+		{http.MethodPut, "/admin/cache", `{"hit_prob":2}`, http.StatusBadRequest},
+		{http.MethodGet, "/admin/events?since=yesterday", "", http.StatusBadRequest},
+	} {
+		if rec := do(mux, tc.method, tc.target, tc.body); rec.Code != tc.want {
+			t.Errorf("%s %s %s = %d %s, want %d", tc.method, tc.target, tc.body, rec.Code, rec.Body.String(), tc.want)
+		}
+	}
+
+	// Only the valid changes are applied
+	var cfg runtimeConfig
+	if err := json.Unmarshal(do(mux, http.MethodGet, "/admin/config", "").Body.Bytes(), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Workload != "sha256" || cfg.Params.Size != 1024 || cfg.Faults.ErrorRate != 0.5 || !cfg.StallUntil.IsZero() {
+		t.Errorf("config = %+v, want sha256 size 1024, error rate 0.5 and no stall", cfg)
+	}
+}
+
+func TestAdminStallAndEvents(t *testing.T) {
+	s := newTestServer(t)
+	mux := newAdminMux(s)
+	start := time.Now()
+
+	if rec := do(mux, http.MethodPost, "/admin/stall", `{"seconds":60}`); rec.Code != http.StatusOK {
+		t.Fatalf("stall = %d %s", rec.Code, rec.Body.String())
+	}
+	if until := s.config().StallUntil; until.Before(start.Add(59 * time.Second)) {
+		t.Fatalf("stall until %v, want about a minute from now", until)
+	}
+
+	// A request during the stall ends as soon as its client goes away
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := s.calculate(ctx, CalculationRequest{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("calculate during stall = %v, want context.DeadlineExceeded", err)
+	}
+
+	// Clearing the faults ends the stall
+	if rec := do(mux, http.MethodDelete, "/admin/faults", ""); rec.Code != http.StatusOK {
+		t.Fatalf("clear faults = %d", rec.Code)
+	}
+	if rec := do(mux, http.MethodPost, "/calculate", "{}"); rec.Code != http.StatusOK {
+		t.Fatalf("calculate after clearing the stall = %d %s", rec.Code, rec.Body.String())
+	}
+
+	var events []adminEvent
+	if err := json.Unmarshal(do(mux, http.MethodGet, "/admin/events?since="+start.Format(time.RFC3339Nano), "").Body.Bytes(), &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Type != eventStall || events[1].Type != eventFaults {
+		t.Errorf("events since start = %+v, want a stall then a faults event", events)
+	}
+	future := time.Now().Add(time.Hour).Format(time.RFC3339Nano)
+	if body := do(mux, http.MethodGet, "/admin/events?since="+future, "").Body.String(); strings.TrimSpace(body) != "[]" {
+		t.Errorf("events since the future = %s, want []", body)
+	}
+}
+
+func TestInjectedFaults(t *testing.T) {
+	s := newTestServer(t)
+	mux := newAdminMux(s)
+	sleep := `{"kind":"sleep","size":5}`
+
+	do(mux, http.MethodPut, "/admin/faults", `{"error_rate":1}`)
+	if rec := do(mux, http.MethodPost, "/calculate", sleep); rec.Code != http.StatusInternalServerError {
+		t.Errorf("calculate with error_rate 1 = %d, want 500", rec.Code)
+	}
+
+	// Latency spikes are reported as a separate fault metric, not as compute time
+	do(mux, http.MethodPut, "/admin/faults", `{"latency_spike_prob":1,"latency_spike_ms":50}`)
+	rec := do(mux, http.MethodPost, "/calculate", sleep)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("calculate with spikes = %d %s", rec.Code, rec.Body.String())
+	}
+	timing := servertiming.Parse(rec.Header().Get("Server-Timing"))
+	if fault := timing["fault"].Dur; fault < 50 {
+		t.Errorf("fault = %vms, want at least the 50ms spike", fault)
+	}
+	if compute := timing["compute"].Dur; compute < 5 || compute >= 50 {
+		t.Errorf("compute = %vms, want the 5ms sleep without the spike", compute)
+	}
+
+	// A client leaving during a spike frees the worker immediately
+	do(mux, http.MethodPut, "/admin/faults", `{"latency_spike_prob":1,"latency_spike_ms":60000}`)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	if _, err := s.calculate(ctx, CalculationRequest{Kind: "sleep", Size: 1}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("calculate with a cancelled spike = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(begin); elapsed > 10*time.Second {
+		t.Errorf("cancelled spike took %v", elapsed)
+	}
+
+	// Without faults there is no fault metric
+	do(mux, http.MethodDelete, "/admin/faults", "")
+	rec = do(mux, http.MethodPost, "/calculate", sleep)
+	if h := rec.Header().Get("Server-Timing"); strings.Contains(h, "fault") {
+		t.Errorf("Server-Timing without faults = %q", h)
+	}
+}
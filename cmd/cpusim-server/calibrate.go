@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"cpusim/calculator"
+)
+
+// runCalibrateMode sweeps concurrency from 1 to maxConcurrency, measuring the full service-time
+// distribution at each level, and writes the resulting profile as JSON to output
+func runCalibrateMode(durationSec, maxConcurrency int, workload string, params calculator.Params, dist *calculator.ServiceTimeDist, output string) {
+	if maxConcurrency <= 0 {
+		maxConcurrency = runtime.NumCPU()
+	}
+
+	calc := calculator.New()
+	w, p, err := calc.Resolve(workload, params)
+	if err != nil {
+		log.Fatalf("无效的负载参数: %v", err)
+	}
+
+	levelDuration := time.Duration(durationSec) * time.Second
+	log.Printf("Calibrate模式: 负载 %s (size=%d, iterations=%d), 并发 1..%d, 每级 %v",
+		w.Name(), p.Size, p.Iterations, maxConcurrency, levelDuration)
+
+	// 预热：构建共享的操作数/缓冲区，避免计入第一个级别
+	calculator.Execute(w, p)
+
+	levels := make([]calculator.ProfileLevel, 0, maxConcurrency)
+	for c := 1; c <= maxConcurrency; c++ {
+		samples, elapsed := measureLevel(w, p, dist, c, levelDuration)
+		level := calculator.NewProfileLevel(c, samples, elapsed)
+		levels = append(levels, level)
+		log.Printf("并发 %d: 吞吐=%.2f/s, 每核=%.2f/s, 平均=%.3fms, P99=%.3fms, CV=%.2f",
+			c, level.Throughput, level.PerCore, level.Mean, level.P99, level.CV)
+	}
+
+	profile := calculator.NewProfile(w.Name(), p, dist.Config(), levelDuration,
+		runtime.NumCPU(), runtime.GOMAXPROCS(0), levels)
+
+	data, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		log.Fatalf("画像编码失败: %v", err)
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		log.Fatalf("写入画像失败: %v", err)
+	}
+
+	log.Println("\n=== Calibrate 结果 ===")
+	log.Printf("单核服务率 μ: %.2f/s", profile.ServiceRate)
+	log.Printf("峰值吞吐: %.2f/s (并发 %d)", profile.PeakThroughput, profile.PeakConcurrency)
+	for _, l := range profile.Levels {
+		log.Printf("并发 %d: 扩展效率 %.1f%%", l.Concurrency, l.ScalingEfficiency*100)
+	}
+	log.Printf("画像已写入: %s", output)
+	log.Println("====================")
+}
+
+// measureLevel runs the workload on concurrency goroutines for d and returns every
+// service time in milliseconds together with the measured wall time
+func measureLevel(w calculator.Workload, p calculator.Params, dist *calculator.ServiceTimeDist, concurrency int, d time.Duration) ([]float64, time.Duration) {
+	// 每个 goroutine 独立收集样本，结束后合并
+	perWorker := make([][]float64, concurrency)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	deadline := start.Add(d)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			for time.Now().Before(deadline) {
+				t := time.Now()
+				calculator.Execute(w, dist.Apply(w, p))
+				perWorker[id] = append(perWorker[id], float64(time.Since(t).Nanoseconds())/1e6)
+			}
+		}(i)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	var samples []float64
+	for _, s := range perWorker {
+		samples = append(samples, s...)
+	}
+	return samples, elapsed
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"cpusim/calculator"
+)
+
+func TestCalibrateWritesLoadableProfile(t *testing.T) {
+	dist, err := calculator.NewServiceTimeDist(calculator.DistConfig{Kind: calculator.DistDeterministic})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "profile.json")
+
+	// 1ms sleeps make the expected throughput independent of the machine
+	params := calculator.Params{Size: 1, Iterations: 1}
+	runCalibrateMode(1, 2, "sleep", params, dist, path)
+
+	profile, err := calculator.LoadProfile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Kind != "sleep" || profile.Params != params || len(profile.Levels) != 2 {
+		t.Fatalf("profile = %s %+v with %d levels, want sleep %+v with 2 levels",
+			profile.Kind, profile.Params, len(profile.Levels), params)
+	}
+	for _, l := range profile.Levels {
+		if l.Mean < 1 || l.Throughput <= 0 || l.Throughput > float64(l.Concurrency)*1000 {
+			t.Errorf("level %d: mean %.3fms, throughput %.1f/s, want at least 1ms and at most %d/s",
+				l.Concurrency, l.Mean, l.Throughput, l.Concurrency*1000)
+		}
+	}
+
+	// The suggested range of the measured capacity always fits the experiment group API
+	qpsMin, qpsMax, qpsStep := calculator.SuggestQPSRange(profile.Capacity(0)*10, 9, 1000)
+	if qpsMin < 1 || qpsMax > 1000 || qpsMin > qpsMax || qpsStep < 1 {
+		t.Errorf("SuggestQPSRange = %d, %d, %d, want a range within [1, 1000]", qpsMin, qpsMax, qpsStep)
+	}
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cpusim/internal/servertiming"
+)
+
+// Downstream call patterns
+const (
+	patternSequential = "sequential" // call every downstream one after another (a chain)
+	patternFanout     = "fanout"     // call every downstream in parallel and wait for all of them (fan-out/fan-in)
+	patternRandom     = "random"     // call at most one downstream, picked with the configured probabilities
+)
+
+// hopTiming is the client-side view of one downstream call, including the hops behind it
+type hopTiming struct {
+	Target     string      `json:"target"`
+	Status     int         `json:"status,omitempty"` // HTTP status, 0 when the call failed before a response
+	DurationMs float64     `json:"duration_ms"`      // round trip observed by this server
+	QueueMs    float64     `json:"queue_ms"`         // downstream queueing, from its Server-Timing header
+	ComputeMs  float64     `json:"compute_ms"`       // downstream local work, from its Server-Timing header
+	Error      string      `json:"error,omitempty"`
+	Downstream []hopTiming `json:"downstream,omitempty"` // hops reported by the downstream itself
+}
+
+// downstream calls other cpusim instances after the local work of every request, so that
+// multi-tier topologies can be assembled from several local processes
+type downstream struct {
+	targets []string
+	pattern string
+	probs   []float64 // routing probabilities for patternRandom, the remainder ends the request locally
+
+	client *http.Client
+
+	// onCall, if set, is called with the outcome of every downstream call
+	onCall func(target string, d time.Duration, failed bool)
+}
+
+// newDownstream parses the -downstream flags. targets is a comma separated list of calculate URLs
+// and probs an optional comma separated list of routing probabilities for the random pattern
+// (empty means uniform). It returns nil when no targets are configured.
+func newDownstream(targets, pattern, probs string, timeout time.Duration) (*downstream, error) {
+	if strings.TrimSpace(targets) == "" {
+		return nil, nil
+	}
+
+	d := &downstream{
+		pattern: pattern,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				MaxIdleConns:        1000,
+				MaxIdleConnsPerHost: 1000, // 每个上游工作者都可能同时调用同一个下游
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}
+	for _, target := range strings.Split(targets, ",") {
+		target = strings.TrimSpace(target)
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid downstream URL: %q", target)
+		}
+		d.targets = append(d.targets, target)
+	}
+
+	switch pattern {
+	case patternSequential, patternFanout:
+		if probs != "" {
+			return nil, fmt.Errorf("downstream probabilities are only used with the %s pattern", patternRandom)
+		}
+	case patternRandom:
+		if probs == "" {
+			for range d.targets {
+				d.probs = append(d.probs, 1/float64(len(d.targets)))
+			}
+			break
+		}
+		var sum float64
+		for _, s := range strings.Split(probs, ",") {
+			p, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil || p < 0 {
+				return nil, fmt.Errorf("invalid downstream probability: %q", s)
+			}
+			sum += p
+			d.probs = append(d.probs, p)
+		}
+		if len(d.probs) != len(d.targets) {
+			return nil, fmt.Errorf("got %d downstream probabilities for %d downstream URLs", len(d.probs), len(d.targets))
+		}
+		if sum > 1+1e-9 {
+			return nil, fmt.Errorf("downstream probabilities must sum to at most 1, got %v", sum)
+		}
+	default:
+		return nil, fmt.Errorf("unknown downstream pattern: %s (supported: sequential, fanout, random)", pattern)
+	}
+
+	return d, nil
+}
+
+// call runs the downstream calls of one request according to the pattern and returns the
+// per-hop timings and the wall time spent on them. The error is set when any call failed.
+// A nil downstream makes no calls.
+func (d *downstream) call(ctx context.Context) ([]hopTiming, time.Duration, error) {
+	if d == nil {
+		return nil, 0, nil
+	}
+
+	start := time.Now()
+	var hops []hopTiming
+
+	switch d.pattern {
+	case patternSequential:
+		// 链式调用：遇到失败即停止，与真实服务的错误传播一致
+		for _, target := range d.targets {
+			hop := d.callOne(ctx, target)
+			hops = append(hops, hop)
+			if hop.Error != "" {
+				break
+			}
+		}
+
+	case patternFanout:
+		hops = make([]hopTiming, len(d.targets))
+		var wg sync.WaitGroup
+		for i, target := range d.targets {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				hops[i] = d.callOne(ctx, target)
+			}()
+		}
+		wg.Wait()
+
+	case patternRandom:
+		r := rand.Float64()
+		for i, p := range d.probs {
+			if r < p {
+				hops = append(hops, d.callOne(ctx, d.targets[i]))
+				break
+			}
+			r -= p
+		}
+	}
+
+	elapsed := time.Since(start)
+	for _, hop := range hops {
+		if hop.Error != "" {
+			return hops, elapsed, fmt.Errorf("%s: %s", hop.Target, hop.Error)
+		}
+	}
+	return hops, elapsed, nil
+}
+
+// callOne sends an empty calculate request to target, so that the downstream runs its own default workload
+func (d *downstream) callOne(ctx context.Context, target string) hopTiming {
+	hop := hopTiming{Target: target}
+	start := time.Now()
+	if d.onCall != nil {
+		defer func() { d.onCall(target, time.Since(start), hop.Error != "") }()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader([]byte("{}")))
+	if err != nil {
+		hop.Error = err.Error()
+		return hop
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		hop.DurationMs = float64(time.Since(start).Nanoseconds()) / 1e6
+		hop.Error = err.Error()
+		return hop
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	hop.DurationMs = float64(time.Since(start).Nanoseconds()) / 1e6
+	hop.Status = resp.StatusCode
+	if err != nil {
+		hop.Error = err.Error()
+		return hop
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		hop.Error = fmt.Sprintf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		return hop
+	}
+
+	timing := servertiming.Parse(resp.Header.Get("Server-Timing"))
+	hop.QueueMs = timing["queue"].Dur
+	hop.ComputeMs = timing["compute"].Dur
+
+	// 下游自身的下游调用耗时嵌套在响应体中
+	var downstreamResp CalculationResponse
+	if err := json.Unmarshal(body, &downstreamResp); err == nil {
+		hop.Downstream = downstreamResp.Downstream
+	}
+	return hop
+}
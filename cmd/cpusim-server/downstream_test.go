@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewDownstream(t *testing.T) {
+	const a, b = "http://a:8080/calculate", "http://b:8080/calculate"
+
+	for _, tc := range []struct {
+		name                    string
+		targets, pattern, probs string
+		wantProbs               []float64
+		ok                      bool
+	}{
+		{"sequential", a + ", " + b, patternSequential, "", nil, true},
+		{"fanout", a, patternFanout, "", nil, true},
+		{"random uniform", a + "," + b, patternRandom, "", []float64{0.5, 0.5}, true},
+		{"random with probabilities", a + "," + b, patternRandom, "0.2, 0.5", []float64{0.2, 0.5}, true},
+		{"probabilities summing to 1", a + "," + b, patternRandom, "0.25,0.75", []float64{0.25, 0.75}, true},
+		{"probabilities above 1", a + "," + b, patternRandom, "0.6,0.5", nil, false},
+		{"negative probability", a + "," + b, patternRandom, "-0.1,0.5", nil, false},
+		{"invalid probability", a, patternRandom, "half", nil, false},
+		{"too few probabilities", a + "," + b, patternRandom, "0.5", nil, false},
+		{"too many probabilities", a, patternRandom, "0.2,0.2", nil, false},
+		{"probabilities without random", a, patternSequential, "1", nil, false},
+		{"unknown pattern", a, "broadcast", "", nil, false},
+		{"missing scheme", "a:8080/calculate", patternSequential, "", nil, false},
+		{"unsupported scheme", "ftp://a/calculate", patternSequential, "", nil, false},
+		{"empty target", a + ",", patternSequential, "", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := newDownstream(tc.targets, tc.pattern, tc.probs, time.Second)
+			if !tc.ok {
+				if err == nil {
+					t.Errorf("newDownstream(%q, %q, %q) accepted an invalid configuration", tc.targets, tc.pattern, tc.probs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newDownstream(%q, %q, %q) = %v", tc.targets, tc.pattern, tc.probs, err)
+			}
+			if len(d.targets) == 0 || d.pattern != tc.pattern || !slices.Equal(d.probs, tc.wantProbs) {
+				t.Errorf("newDownstream = %v %s %v, want probabilities %v", d.targets, d.pattern, d.probs, tc.wantProbs)
+			}
+		})
+	}
+
+	// Without targets there is nothing to call
+	d, err := newDownstream(" ", patternRandom, "0.5", time.Second)
+	if d != nil || err != nil {
+		t.Errorf("newDownstream without targets = %v, %v, want nil, nil", d, err)
+	}
+	if hops, elapsed, err := d.call(context.Background()); hops != nil || elapsed != 0 || err != nil {
+		t.Errorf("nil downstream call = %v, %v, %v", hops, elapsed, err)
+	}
+}
+
+// countingServer is a downstream that answers every calculate request with fixed timings
+func countingServer(t *testing.T, calls *atomic.Int64) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Server-Timing", serverTiming(time.Millisecond, 2*time.Millisecond, 0, 0, ""))
+		w.Write([]byte(`{"kind":"gcd"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestDownstreamRandomRouting(t *testing.T) {
+	var first, second atomic.Int64
+	d, err := newDownstream(countingServer(t, &first)+","+countingServer(t, &second), patternRandom, "0.2,0.5", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 2000
+	for range n {
+		hops, _, err := d.call(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hops) > 1 {
+			t.Fatalf("random pattern made %d calls, want at most 1", len(hops))
+		}
+		if len(hops) == 1 && (hops[0].QueueMs != 1 || hops[0].ComputeMs != 2) {
+			t.Fatalf("hop = %+v, want queue 1ms and compute 2ms from its Server-Timing header", hops[0])
+		}
+	}
+
+	// The remaining 30% end locally. 4 standard deviations of a binomial share of n calls stay under 0.05.
+	for _, c := range []struct {
+		name  string
+		calls int64
+		want  float64
+	}{
+		{"first", first.Load(), 0.2},
+		{"second", second.Load(), 0.5},
+		{"local", n - first.Load() - second.Load(), 0.3},
+	} {
+		if got := float64(c.calls) / n; math.Abs(got-c.want) > 0.05 {
+			t.Errorf("%s share = %.3f, want %.1f", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDownstreamPatterns(t *testing.T) {
+	var first, second atomic.Int64
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	ok1, ok2 := countingServer(t, &first), countingServer(t, &second)
+
+	// A sequential chain stops at the first failure
+	d, err := newDownstream(ok1+","+failing.URL+","+ok2, patternSequential, "", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hops, _, err := d.call(context.Background())
+	if err == nil || len(hops) != 2 || hops[1].Status != http.StatusServiceUnavailable || second.Load() != 0 {
+		t.Errorf("sequential with a failing hop = %+v, %v, want 2 hops and an error", hops, err)
+	}
+
+	// A fan-out calls every downstream, in target order
+	d, err = newDownstream(ok1+","+ok2, patternFanout, "", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hops, _, err = d.call(context.Background())
+	if err != nil || len(hops) != 2 || hops[0].Target != ok1 || hops[1].Target != ok2 {
+		t.Errorf("fanout = %+v, %v, want one hop per target", hops, err)
+	}
+	if first.Load() != 2 || second.Load() != 1 {
+		t.Errorf("calls = %d, %d, want 2, 1", first.Load(), second.Load())
+	}
+}
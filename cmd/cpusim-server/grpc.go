@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+
+	pb "cpusim/calculator/api/generated"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// priorityMetadata carries the request priority in priority mode, like the X-Priority header
+const priorityMetadata = "x-priority"
+
+// grpcServer implements the gRPC Calculator service on top of the shared server state
+type grpcServer struct {
+	pb.UnimplementedCalculatorServer
+	s *server
+}
+
+// Calculate runs one workload request, the gRPC equivalent of POST /calculate
+func (g *grpcServer) Calculate(ctx context.Context, req *pb.CalculateRequest) (*pb.CalculateResponse, error) {
+	calc, err := g.s.calculate(ctx, CalculationRequest{
+		Kind:       req.GetKind(),
+		Size:       int(req.GetSize()),
+		Iterations: int(req.GetIterations()),
+		Key:        req.GetKey(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errInjectedFault):
+			return nil, status.Error(codes.Internal, err.Error())
+		case ctx.Err() != nil:
+			return nil, status.FromContextError(ctx.Err()).Err()
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	hops, downstreamTime, err := g.s.downstream.call(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "下游调用失败: "+err.Error())
+	}
+
+	// 与HTTP的Server-Timing头格式相同，requester 可以复用同一个解析器
+	wait := queueWaitFrom(ctx)
+	grpc.SetTrailer(ctx, metadata.Pairs("server-timing", serverTiming(wait, calc.processTime, calc.faultTime, downstreamTime, calc.cache)))
+
+	result := calc.result
+	return &pb.CalculateResponse{
+		Kind:         result.Kind,
+		Size:         int32(result.Params.Size),
+		Iterations:   int32(result.Params.Iterations),
+		Result:       result.Value,
+		QueueMs:      float64(wait.Nanoseconds()) / 1e6,
+		ComputeMs:    float64(calc.processTime.Nanoseconds()) / 1e6,
+		DownstreamMs: float64(downstreamTime.Nanoseconds()) / 1e6,
+		Downstream:   hopsToProto(hops),
+		Cache:        calc.cache,
+	}, nil
+}
+
+// hopsToProto converts downstream hop timings, including nested hops, to their protobuf form
+func hopsToProto(hops []hopTiming) []*pb.Hop {
+	if len(hops) == 0 {
+		return nil
+	}
+	out := make([]*pb.Hop, len(hops))
+	for i, hop := range hops {
+		out[i] = &pb.Hop{
+			Target:     hop.Target,
+			Status:     int32(hop.Status),
+			DurationMs: hop.DurationMs,
+			QueueMs:    hop.QueueMs,
+			ComputeMs:  hop.ComputeMs,
+			Error:      hop.Error,
+			Downstream: hopsToProto(hop.Downstream),
+		}
+	}
+	return out
+}
+
+// unaryInterceptor admits gRPC requests through the worker pool, the gRPC equivalent of
+// workerPool.middleware. Requests are rejected with RESOURCE_EXHAUSTED when the queue is full.
+func (p *workerPool) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var priority int
+	if values := metadata.ValueFromIncomingContext(ctx, priorityMetadata); len(values) > 0 {
+		priority, _ = strconv.Atoi(values[0])
+	}
+
+	wait, err := p.acquire(ctx, priority)
+	if err != nil {
+		if errors.Is(err, errQueueFull) {
+			return nil, status.Error(codes.ResourceExhausted, "服务繁忙: 等待队列已满")
+		}
+		return nil, status.FromContextError(err).Err()
+	}
+	defer p.release()
+
+	if p.onWait != nil {
+		p.onWait(wait)
+	}
+
+	return handler(context.WithValue(ctx, queueWaitKey{}, wait), req)
+}
+
+// unaryInterceptor counts gRPC requests by status code and tracks the shared in-flight gauge
+func (m *serverMetrics) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	m.inFlight.Inc()
+	defer m.inFlight.Dec()
+
+	resp, err := handler(ctx, req)
+	m.grpcRequests.WithLabelValues(status.Code(err).String()).Inc()
+	return resp, err
+}
+
+// serveGRPC serves the Calculator service on port until the listener fails
+func serveGRPC(s *server, port int) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("gRPC服务: 监听端口 %s", lis.Addr())
+	return newGRPCServer(s).Serve(lis)
+}
+
+// newGRPCServer creates the gRPC server with the Calculator service behind the metrics and worker pool interceptors
+func newGRPCServer(s *server) *grpc.Server {
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(s.metrics.unaryInterceptor, s.pool.unaryInterceptor))
+	pb.RegisterCalculatorServer(srv, &grpcServer{s: s})
+	return srv
+}
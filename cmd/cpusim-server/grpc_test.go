@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"cpusim/calculator"
+	pb "cpusim/calculator/api/generated"
+	"cpusim/internal/servertiming"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newBufconnClient serves s over an in-memory gRPC connection and returns a client for it
+func newBufconnClient(t *testing.T, s *server) pb.CalculatorClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := newGRPCServer(s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewCalculatorClient(conn)
+}
+
+func TestGRPCMatchesHTTP(t *testing.T) {
+	s := newTestServer(t)
+	client := newBufconnClient(t, s)
+	mux := newAdminMux(s)
+
+	for _, req := range []CalculationRequest{
+		{},
+		{Kind: "gcd", Size: 300, Iterations: 2},
+		{Kind: "sha256", Size: 1024, Iterations: 3},
+		{Kind: "matrix", Size: 16},
+		// With the LRU on, the gRPC call hits the entry stored by the HTTP request of the same key
+		{Kind: "gcd", Size: 500, Key: "k"},
+	} {
+		if req.Key != "" {
+			if err := s.cache.Configure(calculator.CacheConfig{Size: 10}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		body, _ := json.Marshal(req)
+		rec := do(mux, http.MethodPost, "/calculate", string(body))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("HTTP %+v = %d %s", req, rec.Code, rec.Body.String())
+		}
+		var httpResp CalculationResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &httpResp); err != nil {
+			t.Fatal(err)
+		}
+
+		var trailer metadata.MD
+		grpcResp, err := client.Calculate(context.Background(), &pb.CalculateRequest{
+			Kind:       req.Kind,
+			Size:       int32(req.Size),
+			Iterations: int32(req.Iterations),
+			Key:        req.Key,
+		}, grpc.Trailer(&trailer))
+		if err != nil {
+			t.Fatalf("gRPC %+v: %v", req, err)
+		}
+
+		if grpcResp.Kind != httpResp.Kind || int(grpcResp.Size) != httpResp.Size ||
+			int(grpcResp.Iterations) != httpResp.Iterations || grpcResp.Result != httpResp.Result {
+			t.Errorf("%+v: gRPC %s size %d × %d = %s, HTTP %s size %d × %d = %s", req,
+				grpcResp.Kind, grpcResp.Size, grpcResp.Iterations, grpcResp.Result,
+				httpResp.Kind, httpResp.Size, httpResp.Iterations, httpResp.Result)
+		}
+
+		// The trailer carries the same Server-Timing metrics as the HTTP header
+		header := rec.Header().Get("Server-Timing")
+		values := trailer.Get("server-timing")
+		if len(values) != 1 {
+			t.Fatalf("%+v: server-timing trailer = %q, want one value", req, values)
+		}
+		httpTiming, grpcTiming := servertiming.Parse(header), servertiming.Parse(values[0])
+		if got, want := slices.Sorted(maps.Keys(grpcTiming)), slices.Sorted(maps.Keys(httpTiming)); !slices.Equal(got, want) {
+			t.Errorf("%+v: gRPC timing metrics %v, HTTP %v", req, got, want)
+		}
+		if req.Key != "" {
+			if !strings.Contains(header, "cache;desc=miss") || !strings.Contains(values[0], "cache;desc=hit") || grpcResp.Cache != "hit" {
+				t.Errorf("%+v: HTTP timing %q, gRPC timing %q and cache %q, want an HTTP miss then a gRPC hit",
+					req, header, values[0], grpcResp.Cache)
+			}
+		} else if strings.Contains(header+values[0], "cache") {
+			t.Errorf("%+v: cache outcome with the cache off, HTTP %q, gRPC %q", req, header, values[0])
+		}
+
+		// The response fields match the trailer they were reported with
+		if math.Abs(grpcTiming["compute"].Dur-grpcResp.ComputeMs) > 0.001 || math.Abs(grpcTiming["queue"].Dur-grpcResp.QueueMs) > 0.001 {
+			t.Errorf("%+v: trailer %v, response compute %v queue %v", req, grpcTiming, grpcResp.ComputeMs, grpcResp.QueueMs)
+		}
+	}
+}
+
+func TestGRPCInvalidArgument(t *testing.T) {
+	client := newBufconnClient(t, newTestServer(t))
+	_, err := client.Calculate(context.Background(), &pb.CalculateRequest{Kind: "nope"})
+	if err == nil || !strings.Contains(err.Error(), "InvalidArgument") {
+		t.Errorf("unknown kind = %v, want InvalidArgument like HTTP 400", err)
+	}
+}
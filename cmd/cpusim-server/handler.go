@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"cpusim/calculator"
+)
+
+type CalculationRequest struct {
+	// 负载类型，为空时使用服务端默认负载（-workload）；请求体可以为空
+	Kind string `json:"kind,omitempty"`
+	// 负载规模（gcd 为操作数位数），为 0 时使用服务端默认值
+	Size int `json:"size,omitempty"`
+	// 迭代次数，为 0 时使用服务端默认值
+	Iterations int `json:"iterations,omitempty"`
+	// 缓存 key，启用 LRU（-cache-size）时按 key 判断是否命中，为空时按命中概率抽样
+	Key string `json:"key,omitempty"`
+}
+
+type CalculationResponse struct {
+	Kind        string  `json:"kind"`
+	Size        int     `json:"size"`               // 实际生效的负载规模
+	Iterations  int     `json:"iterations"`         // 实际生效的迭代次数
+	Fraction    float64 `json:"fraction,omitempty"` // 服务时间分布缩放后额外执行的不完整一轮的比例
+	Result      string  `json:"result"`
+	GCD         string  `json:"gcd,omitempty"`
+	ProcessTime string  `json:"process_time"`
+	Cache       string  `json:"cache,omitempty"` // hit 或 miss，缓存仿真关闭时为空
+	// 下游调用的逐跳耗时，仅在配置了 -downstream 时返回
+	Downstream     []hopTiming `json:"downstream,omitempty"`
+	DownstreamTime string      `json:"downstream_time,omitempty"`
+}
+
+// server holds the state shared by the cpusim HTTP handlers
+type server struct {
+	// mu guards cfg, which the admin API changes at runtime
+	mu  sync.RWMutex
+	cfg runtimeConfig
+
+	// events records every runtime configuration change
+	events *eventLog
+
+	// calc is created once and shared by all requests, so that per-request
+	// service time only contains the workload itself
+	calc *calculator.Calculator
+
+	// dist draws the per-request work amount
+	dist *calculator.ServiceTimeDist
+
+	// pool admits calculate requests to a fixed number of workers
+	pool *workerPool
+
+	// metrics are exported on /metrics
+	metrics *serverMetrics
+
+	// downstream is called after the local work of every request, nil when not configured
+	downstream *downstream
+
+	// cache decides which requests are cheap cache hits, the admin API can reconfigure it
+	cache *calculator.Cache
+}
+
+// calculation is the outcome of one calculate request
+type calculation struct {
+	result      *calculator.Result
+	processTime time.Duration // the workload (or cache lookup) alone
+	faultTime   time.Duration // injected stall and latency spike, not part of processTime
+	cache       string        // cacheHit, cacheMiss, or empty when the cache emulation is off
+}
+
+// Cache outcomes reported in responses, Server-Timing and metrics
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+// calculateHandler serves POST /calculate and POST /calculate/{kind}
+func (s *server) calculateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "只支持POST请求", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req CalculationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// 路径中的负载类型优先于请求体
+	if kind := r.PathValue("kind"); kind != "" {
+		req.Kind = kind
+	}
+
+	calc, err := s.calculate(r.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInjectedFault):
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		case r.Context().Err() != nil:
+			// The client went away during an injected stall, nothing to write
+		default:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	// 本地计算完成后调用下游，期间继续占用工作者
+	hops, downstreamTime, err := s.downstream.call(r.Context())
+	if err != nil {
+		http.Error(w, "下游调用失败: "+err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// 机器可读的服务端耗时（毫秒），requester 据此区分排队时间和服务时间
+	w.Header().Set("Server-Timing", serverTiming(queueWaitFrom(r.Context()), calc.processTime, calc.faultTime, downstreamTime, calc.cache))
+
+	result := calc.result
+	response := CalculationResponse{
+		Kind:        result.Kind,
+		Size:        result.Params.Size,
+		Iterations:  result.Params.Iterations,
+		Fraction:    result.Params.Fraction,
+		Result:      result.Value,
+		ProcessTime: calc.processTime.String(),
+		Cache:       calc.cache,
+		Downstream:  hops,
+	}
+	if s.downstream != nil {
+		response.DownstreamTime = downstreamTime.String()
+	}
+	if result.Kind == "gcd" {
+		response.GCD = result.Value
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// errInjectedFault is returned by calculate for errors injected through the admin API
+var errInjectedFault = errors.New("注入的故障: 服务内部错误")
+
+// calculate runs one request with the current runtime configuration, shared by the HTTP and gRPC
+// transports. It returns errInjectedFault for injected errors, the context error when the client
+// left during an injected stall, and any other error for an invalid request.
+func (s *server) calculate(ctx context.Context, req CalculationRequest) (*calculation, error) {
+	cfg := s.config()
+
+	kind := req.Kind
+	if kind == "" {
+		kind = cfg.Workload
+	}
+
+	// 请求参数优先；服务端默认规模只作用于默认负载，仍为 0 的字段由负载自身的默认值补全
+	params := calculator.Params{Size: req.Size, Iterations: req.Iterations}
+	if kind == cfg.Workload {
+		if params.Size == 0 {
+			params.Size = cfg.Params.Size
+		}
+		if params.Iterations == 0 {
+			params.Iterations = cfg.Params.Iterations
+		}
+	}
+
+	// 解析参数后按服务时间分布抽取本次请求的工作量
+	workload, params, err := s.calc.Resolve(kind, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 注入的停顿：占用工作者直到停顿结束，后续请求在队列中堆积
+	var faultTime time.Duration
+	if wait := time.Until(cfg.StallUntil); wait > 0 {
+		s.metrics.observeFault(eventStall)
+		if err := sleepCtx(ctx, wait); err != nil {
+			return nil, err
+		}
+		faultTime += wait
+	}
+
+	// 注入的错误：不做任何计算直接返回
+	if cfg.Faults.ErrorRate > 0 && rand.Float64() < cfg.Faults.ErrorRate {
+		s.metrics.observeFault("error")
+		return nil, errInjectedFault
+	}
+
+	// 计算时间从故障注入之后开始，只包含负载本身（或缓存查找）
+	startTime := time.Now()
+
+	// 缓存命中只做一次查找，未命中才执行完整负载
+	var result *calculator.Result
+	key := cacheKey(workload, params, req.Key)
+	value, hit, cacheEnabled := s.cache.Lookup(key)
+	if hit {
+		result = &calculator.Result{Kind: workload.Name(), Params: params, Value: value}
+	} else {
+		params = s.dist.Apply(workload, params)
+		result = calculator.Execute(workload, params)
+		s.cache.Store(key, result.Value)
+	}
+
+	processTime := time.Since(startTime)
+
+	// 注入的延迟尖峰：占用工作者，但单独计入 fault 而不是计算时间
+	if cfg.Faults.LatencySpikeProb > 0 && rand.Float64() < cfg.Faults.LatencySpikeProb {
+		s.metrics.observeFault("latency_spike")
+		spike := time.Duration(cfg.Faults.LatencySpikeMs) * time.Millisecond
+		if err := sleepCtx(ctx, spike); err != nil {
+			return nil, err
+		}
+		faultTime += spike
+	}
+
+	c := &calculation{result: result, processTime: processTime, faultTime: faultTime}
+	if cacheEnabled {
+		c.cache = cacheMiss
+		if hit {
+			c.cache = cacheHit
+		}
+		s.metrics.observeCache(c.cache)
+	}
+	s.metrics.observeService(result.Kind, c.processTime)
+	return c, nil
+}
+
+// sleepCtx waits for d, returning early with the context error when ctx is done
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cacheKey scopes a request key to the workload and its resolved parameters, so that the same key
+// requested with a different size or iteration count is a different entry. It returns "" without a key.
+func cacheKey(w calculator.Workload, p calculator.Params, key string) string {
+	if key == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d/%d/%s", w.Name(), p.Size, p.Iterations, key)
+}
+
+// serverTiming formats the Server-Timing header value with queue wait, compute time and, when
+// non-zero, injected fault delays and the time spent on downstream calls, all in milliseconds.
+// A non-empty cache outcome is added as a description-only metric, e.g. cache;desc=hit.
+func serverTiming(queue, compute, fault, downstream time.Duration, cache string) string {
+	st := fmt.Sprintf("queue;dur=%.3f, compute;dur=%.3f",
+		float64(queue.Nanoseconds())/1e6, float64(compute.Nanoseconds())/1e6)
+	if fault > 0 {
+		st += fmt.Sprintf(", fault;dur=%.3f", float64(fault.Nanoseconds())/1e6)
+	}
+	if downstream > 0 {
+		st += fmt.Sprintf(", downstream;dur=%.3f", float64(downstream.Nanoseconds())/1e6)
+	}
+	if cache != "" {
+		st += ", cache;desc=" + cache
+	}
+	return st
+}
+
+// workloadInfo describes a registered workload in the /workloads response
+type workloadInfo struct {
+	Kind     string            `json:"kind"`
+	Defaults calculator.Params `json:"defaults"`
+	MaxSize  int               `json:"max_size"`
+	MaxWork  int64             `json:"max_work"` // size × iterations limit, in the workload's units
+}
+
+// workloadsHandler lists the registered workload kinds with their default params and limits
+func (s *server) workloadsHandler(w http.ResponseWriter, r *http.Request) {
+	workloads := make([]workloadInfo, 0, len(s.calc.Kinds()))
+	for _, kind := range s.calc.Kinds() {
+		wl, _ := s.calc.Lookup(kind)
+		workloads = append(workloads, workloadInfo{
+			Kind:     kind,
+			Defaults: wl.Defaults(),
+			MaxSize:  wl.MaxSize(),
+			MaxWork:  wl.MaxWork(),
+		})
+	}
+
+	cfg := s.config()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"default":        cfg.Workload,
+		"default_params": cfg.Params,
+		"workloads":      workloads,
+	})
+}
+
+// statsHandler returns the worker pool queue counters
+func (s *server) statsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.pool.stats())
+}
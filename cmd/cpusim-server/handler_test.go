@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cpusim/calculator"
+)
+
+// newTestServer returns a server with the default gcd workload and no injected variance
+func newTestServer(tb testing.TB) *server {
+	dist, err := calculator.NewServiceTimeDist(calculator.DistConfig{Kind: calculator.DistDeterministic})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	pool, err := newWorkerPool(0, 0, disciplineFIFO)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	cache, err := calculator.NewCache(calculator.CacheConfig{})
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return &server{
+		cfg:     runtimeConfig{Workload: calculator.DefaultKind},
+		events:  &eventLog{},
+		calc:    calculator.New(),
+		dist:    dist,
+		pool:    pool,
+		metrics: newServerMetrics(pool),
+		cache:   cache,
+	}
+}
+
+func TestCacheKeyIncludesParams(t *testing.T) {
+	s := newTestServer(t)
+	if err := s.cache.Configure(calculator.CacheConfig{Size: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		req  CalculationRequest
+		want string
+	}{
+		{CalculationRequest{Kind: "gcd", Size: 300, Key: "k"}, cacheMiss},
+		{CalculationRequest{Kind: "gcd", Size: 300, Key: "k"}, cacheHit},
+		// The same key with other parameters is a different entry and returns its own result
+		{CalculationRequest{Kind: "gcd", Size: 500, Key: "k"}, cacheMiss},
+		{CalculationRequest{Kind: "gcd", Size: 500, Iterations: 2, Key: "k"}, cacheMiss},
+		{CalculationRequest{Kind: "sha256", Size: 500, Key: "k"}, cacheMiss},
+		// The default 5 iterations resolve to the same entry as an explicit 5
+		{CalculationRequest{Kind: "gcd", Size: 300, Iterations: 5, Key: "k"}, cacheHit},
+	} {
+		c, err := s.calculate(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("%+v: %v", tc.req, err)
+		}
+		if c.cache != tc.want {
+			t.Errorf("%+v: cache %q, want %q", tc.req, c.cache, tc.want)
+		}
+		if tc.want == cacheHit && c.result.Params.Size != tc.req.Size {
+			t.Errorf("%+v: hit with size %d", tc.req, c.result.Params.Size)
+		}
+	}
+}
+
+// BenchmarkCalculateHandler measures a full /calculate request in-process. Compare with
+// calculator.BenchmarkGCD: the difference is the per-request overhead outside the workload.
+func BenchmarkCalculateHandler(b *testing.B) {
+	s := newTestServer(b)
+	handler := s.metrics.middleware(s.pool.middleware(http.HandlerFunc(s.calculateHandler)))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/calculate", strings.NewReader("{}"))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			b.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+}
@@ -1,26 +1,17 @@
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"cpusim/calculator"
 )
 
-type CalculationRequest struct {
-	// 请求体可以为空，因为使用固定值
-}
-
-type CalculationResponse struct {
-	GCD         string `json:"gcd"`
-	ProcessTime string `json:"process_time"`
-}
-
 // BenchmarkStats stores benchmark statistics
 type BenchmarkStats struct {
 	totalCount   int64
@@ -43,10 +34,33 @@ func (s *BenchmarkStats) snapshot() (count int64, avgDuration time.Duration) {
 
 func main() {
 	// Command line flags
-	mode := flag.String("mode", "server", "运行模式: server (HTTP服务器) 或 benchmark (性能测试)")
-	duration := flag.Int("duration", 10, "benchmark模式下的运行时长（秒）")
+	mode := flag.String("mode", "server", "运行模式: server (HTTP服务器), benchmark (性能测试), calibrate (生成服务时间画像) 或 neighbour (仅提供后台干扰负载API)")
+	duration := flag.Int("duration", 10, "benchmark模式下的运行时长（秒），calibrate模式下每个并发级别的时长")
 	concurrency := flag.Int("concurrency", 1, "benchmark模式下的并发数")
-	port := flag.Int("port", 80, "server模式下的监听端口")
+	port := flag.Int("port", 80, "server/neighbour模式下的监听端口")
+	workload := flag.String("workload", calculator.DefaultKind, "默认负载类型，请求未指定kind时使用")
+	size := flag.Int("size", 0, "默认负载规模（gcd为操作数位数），0表示使用负载自身的默认值")
+	iterations := flag.Int("iterations", 0, "默认迭代次数，0表示使用负载自身的默认值")
+	dist := flag.String("dist", calculator.DistDeterministic, "服务时间分布: deterministic, exponential, lognormal, bimodal（按分布连续缩放每个请求的工作量）")
+	distSeed := flag.Int64("dist-seed", 0, "服务时间分布的随机种子，0表示使用当前时间")
+	distSigma := flag.Float64("dist-sigma", 1.0, "lognormal分布中ln(X)的标准差")
+	bimodalProb := flag.Float64("dist-bimodal-p", 0.1, "bimodal分布中慢请求的概率")
+	bimodalRatio := flag.Float64("dist-bimodal-ratio", 10, "bimodal分布中慢请求与快请求的工作量之比")
+	workers := flag.Int("workers", 0, "server模式下的工作者数量c（同时处理的请求数），0表示不限制")
+	maxQueue := flag.Int("max-queue", 0, "等待队列的最大长度，队列满时返回503，0表示不限制")
+	discipline := flag.String("queue-discipline", disciplineFIFO, "等待队列的调度策略: fifo, lifo, priority（按X-Priority请求头，越大越优先）")
+	maxConcurrency := flag.Int("max-concurrency", 0, "calibrate模式下扫描的最大并发数，0表示CPU核数")
+	profilePath := flag.String("profile", "cpusim-profile.json", "calibrate模式下输出的服务时间画像文件")
+	protocol := flag.String("protocol", protocolH1, "server模式下的协议: h1, h2c（明文HTTP/2）, h3（基于QUIC的HTTP/3）")
+	tlsCert := flag.String("tls-cert", "", "h3协议使用的TLS证书文件，为空时生成自签名证书")
+	tlsKey := flag.String("tls-key", "", "h3协议使用的TLS私钥文件")
+	grpcPort := flag.Int("grpc-port", 0, "server模式下gRPC Calculate服务的监听端口，0表示不启用")
+	downstreamURLs := flag.String("downstream", "", "server模式下每个请求在本地计算后调用的下游计算接口URL，逗号分隔，如 http://localhost:8081/calculate")
+	downstreamPattern := flag.String("downstream-pattern", patternSequential, "下游调用方式: sequential（依次调用，链式）, fanout（并行调用全部下游后汇合）, random（按概率选择一个下游）")
+	downstreamProbs := flag.String("downstream-probs", "", "random方式下各下游的概率，逗号分隔，总和不超过1，剩余概率不调用下游；为空时均匀选择")
+	cacheHitProb := flag.Float64("cache-hit-prob", 0, "server模式下请求命中缓存（几乎不做工作）的概率，0表示关闭缓存仿真")
+	cacheSize := flag.Int("cache-size", 0, "按请求key缓存的LRU容量（条目数），0表示不按key缓存，所有请求按-cache-hit-prob抽样")
+	downstreamTimeout := flag.Duration("downstream-timeout", 5*time.Second, "每次下游调用的超时时间")
 	flag.Parse()
 
 	// 初始化calculator并显示使用的固定数字信息
@@ -56,31 +70,114 @@ func main() {
 	log.Printf("A长度: %d位", len(a.String()))
 	log.Printf("B长度: %d位", len(b.String()))
 
+	if _, ok := calc.Lookup(*workload); !ok {
+		log.Fatalf("无效的负载类型: %s (支持: %s)", *workload, strings.Join(calc.Kinds(), ", "))
+	}
+	params := calculator.Params{Size: *size, Iterations: *iterations}
+	if _, _, err := calc.Resolve(*workload, params); err != nil {
+		log.Fatalf("无效的负载参数: %v", err)
+	}
+	log.Printf("默认负载: %s, 规模: %d, 迭代次数: %d (0表示负载默认值)", *workload, params.Size, params.Iterations)
+
+	serviceDist, err := calculator.NewServiceTimeDist(calculator.DistConfig{
+		Kind:         *dist,
+		Seed:         *distSeed,
+		Sigma:        *distSigma,
+		BimodalProb:  *bimodalProb,
+		BimodalRatio: *bimodalRatio,
+	})
+	if err != nil {
+		log.Fatalf("无效的服务时间分布: %v", err)
+	}
+
 	switch *mode {
 	case "server":
-		runServerMode(*port)
+		pool, err := newWorkerPool(*workers, *maxQueue, *discipline)
+		if err != nil {
+			log.Fatalf("无效的工作者池配置: %v", err)
+		}
+		if err := validateProtocol(*protocol, *tlsCert, *tlsKey); err != nil {
+			log.Fatalf("无效的协议: %v", err)
+		}
+		down, err := newDownstream(*downstreamURLs, *downstreamPattern, *downstreamProbs, *downstreamTimeout)
+		if err != nil {
+			log.Fatalf("无效的下游配置: %v", err)
+		}
+		log.Printf("服务时间分布: %s (seed=%d)", *dist, serviceDist.Config().Seed)
+		log.Printf("工作者池: workers=%d, max-queue=%d, discipline=%s (0表示不限制)", *workers, *maxQueue, *discipline)
+		cache, err := calculator.NewCache(calculator.CacheConfig{HitProb: *cacheHitProb, Size: *cacheSize})
+		if err != nil {
+			log.Fatalf("无效的缓存配置: %v", err)
+		}
+		if *cacheHitProb > 0 || *cacheSize > 0 {
+			log.Printf("缓存仿真: hit-prob=%v, size=%d", *cacheHitProb, *cacheSize)
+		}
+		if down != nil {
+			log.Printf("下游调用: %s, 方式 %s", strings.Join(down.targets, ", "), down.pattern)
+		}
+		runServerMode(calc, *port, *grpcPort, *protocol, *tlsCert, *tlsKey, *workload, params, serviceDist, pool, down, cache)
 	case "benchmark":
-		runBenchmarkMode(*duration, *concurrency)
+		runBenchmarkMode(*duration, *concurrency, *workload, params)
+	case "calibrate":
+		runCalibrateMode(*duration, *maxConcurrency, *workload, params, serviceDist, *profilePath)
+	case "neighbour":
+		runNeighbourMode(*port)
 	default:
-		log.Fatalf("未知模式: %s (支持: server, benchmark)", *mode)
+		log.Fatalf("未知模式: %s (支持: server, benchmark, calibrate, neighbour)", *mode)
 	}
 }
 
-// runServerMode runs the HTTP server mode
-func runServerMode(port int) {
-	http.HandleFunc("/calculate", calculateHandler)
+// runServerMode runs the HTTP server mode, and the gRPC service when grpcPort is set.
+// down may be nil when no downstream instances are configured.
+func runServerMode(calc *calculator.Calculator, port, grpcPort int, protocol, tlsCert, tlsKey, workload string, params calculator.Params, dist *calculator.ServiceTimeDist, pool *workerPool, down *downstream, cache *calculator.Cache) {
+	s := &server{
+		cfg:        runtimeConfig{Workload: workload, Params: params},
+		events:     &eventLog{},
+		calc:       calc,
+		dist:       dist,
+		pool:       pool,
+		metrics:    newServerMetrics(pool),
+		downstream: down,
+		cache:      cache,
+	}
+	pool.onWait = s.metrics.observeQueueWait
+	if down != nil {
+		down.onCall = s.metrics.observeDownstream
+	}
+	s.events.record(eventStart, s.cfg)
+
+	// Only the calculate endpoints go through the worker pool
+	calculate := s.metrics.middleware(pool.middleware(http.HandlerFunc(s.calculateHandler)))
+
+	mux := http.NewServeMux()
+	mux.Handle("/calculate", calculate)
+	mux.Handle("/calculate/{kind}", calculate)
+	mux.HandleFunc("/workloads", s.workloadsHandler)
+	mux.HandleFunc("/stats", s.statsHandler)
+	mux.Handle("/metrics", s.metrics.handler())
+	s.registerAdmin(mux)
+	registerNeighbour(mux, newNeighbour(s.events))
+
+	// gRPC 与 HTTP 共享负载、工作者池、服务时间分布和故障注入
+	if grpcPort > 0 {
+		go func() {
+			if err := serveGRPC(s, grpcPort); err != nil {
+				log.Fatalf("gRPC服务启动失败: %v", err)
+			}
+		}()
+	}
 
 	addr := fmt.Sprintf(":%d", port)
-	log.Printf("Server模式: 监听端口 %s", addr)
+	log.Printf("Server模式: 监听端口 %s, 协议 %s", addr, protocol)
 
-	if err := http.ListenAndServe(addr, nil); err != nil {
+	if err := serve(addr, mux, protocol, tlsCert, tlsKey); err != nil {
 		log.Fatalf("服务启动失败: %v", err)
 	}
 }
 
 // runBenchmarkMode runs the benchmark mode
-func runBenchmarkMode(durationSec, concurrency int) {
-	log.Printf("Benchmark模式: 运行 %d 秒, 并发 %d", durationSec, concurrency)
+func runBenchmarkMode(durationSec, concurrency int, workload string, params calculator.Params) {
+	log.Printf("Benchmark模式: 运行 %d 秒, 并发 %d, 负载 %s", durationSec, concurrency, workload)
 
 	stats := &BenchmarkStats{}
 	deadline := time.Now().Add(time.Duration(durationSec) * time.Second)
@@ -93,7 +190,9 @@ func runBenchmarkMode(durationSec, concurrency int) {
 			count := 0
 			for time.Now().Before(deadline) {
 				start := time.Now()
-				_ = calc.GCD()
+				if _, err := calc.Run(workload, params); err != nil {
+					log.Fatalf("Worker %d: 计算失败: %v", workerID, err)
+				}
 				duration := time.Since(start)
 				stats.recordCalculation(duration)
 				count++
@@ -138,26 +237,3 @@ func runBenchmarkMode(durationSec, concurrency int) {
 	log.Printf("并发数: %d", concurrency)
 	log.Println("====================")
 }
-
-func calculateHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "只支持POST请求", http.StatusMethodNotAllowed)
-		return
-	}
-
-	startTime := time.Now()
-
-	// 创建calculator实例并计算GCD
-	calc := calculator.New()
-	result := calc.GCD()
-
-	processTime := time.Since(startTime)
-
-	response := CalculationResponse{
-		GCD:         result.String(),
-		ProcessTime: processTime.String(),
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
-}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// latencyBuckets covers 0.5ms to ~16s in powers of two
+var latencyBuckets = prometheus.ExponentialBuckets(0.0005, 2, 16)
+
+// serverMetrics holds the Prometheus collectors exported on /metrics
+type serverMetrics struct {
+	registry *prometheus.Registry
+
+	requests     *prometheus.CounterVec
+	grpcRequests *prometheus.CounterVec
+	inFlight     prometheus.Gauge
+	serviceTime  *prometheus.HistogramVec
+	queueWait    prometheus.Histogram
+	faults       *prometheus.CounterVec
+
+	downstreamTime   *prometheus.HistogramVec
+	downstreamErrors *prometheus.CounterVec
+
+	cacheRequests *prometheus.CounterVec
+}
+
+// newServerMetrics registers the request, worker pool and Go runtime collectors
+func newServerMetrics(pool *workerPool) *serverMetrics {
+	m := &serverMetrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cpusim_requests_total",
+			Help: "Calculate requests handled, partitioned by HTTP status code.",
+		}, []string{"code"}),
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cpusim_grpc_requests_total",
+			Help: "gRPC Calculate requests handled, partitioned by gRPC status code.",
+		}, []string{"code"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "cpusim_requests_in_flight",
+			Help: "Calculate requests currently in the server, including queued ones.",
+		}),
+		serviceTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "cpusim_service_time_seconds",
+			Help:    "Time spent executing the workload, excluding queueing.",
+			Buckets: latencyBuckets,
+		}, []string{"kind"}),
+		queueWait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "cpusim_queue_wait_seconds",
+			Help:    "Time requests spent in the worker pool queue.",
+			Buckets: latencyBuckets,
+		}),
+		faults: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cpusim_faults_injected_total",
+			Help: "Faults injected through the admin API, partitioned by fault type.",
+		}, []string{"type"}),
+		downstreamTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "cpusim_downstream_seconds",
+			Help:    "Round trip time of calls to downstream cpusim instances, partitioned by target URL.",
+			Buckets: latencyBuckets,
+		}, []string{"target"}),
+		downstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cpusim_downstream_errors_total",
+			Help: "Failed calls to downstream cpusim instances, partitioned by target URL.",
+		}, []string{"target"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cpusim_cache_requests_total",
+			Help: "Calculate requests seen by the cache emulation, partitioned by result (hit or miss).",
+		}, []string{"result"}),
+	}
+
+	m.registry.MustRegister(
+		m.requests,
+		m.grpcRequests,
+		m.inFlight,
+		m.serviceTime,
+		m.queueWait,
+		m.faults,
+		m.downstreamTime,
+		m.downstreamErrors,
+		m.cacheRequests,
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "cpusim_requests_rejected_total",
+			Help: "Calculate requests rejected with 503 because the queue was full.",
+		}, func() float64 { return float64(pool.stats().Rejected) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "cpusim_queue_length",
+			Help: "Requests currently waiting for a worker.",
+		}, func() float64 { return float64(pool.stats().QueueLength) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "cpusim_workers_busy",
+			Help: "Workers currently executing a request.",
+		}, func() float64 { return float64(pool.stats().Busy) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "cpusim_workers",
+			Help: "Configured number of workers, 0 means unbounded.",
+		}, func() float64 { return float64(pool.workers) }),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return m
+}
+
+// middleware counts requests by status code and tracks the in-flight gauge
+func (m *serverMetrics) middleware(next http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerInFlight(m.inFlight,
+		promhttp.InstrumentHandlerCounter(m.requests, next))
+}
+
+// observeService records the workload execution time of one request
+func (m *serverMetrics) observeService(kind string, d time.Duration) {
+	m.serviceTime.WithLabelValues(kind).Observe(d.Seconds())
+}
+
+// observeQueueWait records the time one request spent queued
+func (m *serverMetrics) observeQueueWait(d time.Duration) {
+	m.queueWait.Observe(d.Seconds())
+}
+
+// observeFault counts one injected fault of the given type
+func (m *serverMetrics) observeFault(faultType string) {
+	m.faults.WithLabelValues(faultType).Inc()
+}
+
+// observeDownstream records one call to a downstream instance
+func (m *serverMetrics) observeDownstream(target string, d time.Duration, failed bool) {
+	m.downstreamTime.WithLabelValues(target).Observe(d.Seconds())
+	if failed {
+		m.downstreamErrors.WithLabelValues(target).Inc()
+	}
+}
+
+// observeCache counts one cache hit or miss
+func (m *serverMetrics) observeCache(result string) {
+	m.cacheRequests.WithLabelValues(result).Inc()
+}
+
+// handler serves the registry in Prometheus text or OpenMetrics format
+func (m *serverMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+	})
+}
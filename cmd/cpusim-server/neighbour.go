@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Background load kinds
+const (
+	neighbourCPU    = "cpu"    // spin on arithmetic, stays in registers and L1
+	neighbourMemory = "memory" // random cache-line writes over a large buffer, thrashes caches and memory bandwidth
+)
+
+// neighbourPeriod is the duty cycle period used to hit the target CPU percentage
+const neighbourPeriod = 10 * time.Millisecond
+
+// maxNeighbourMemoryMB bounds the total buffer of a memory neighbour, size_mb × goroutines
+const maxNeighbourMemoryMB = 16384
+
+// neighbourSink keeps the spin loops from being optimised away
+var neighbourSink atomic.Uint64
+
+// Admin event types for the background load
+const (
+	eventNeighbourStart = "neighbour_start"
+	eventNeighbourStop  = "neighbour_stop"
+)
+
+// neighbourConfig describes the background load
+type neighbourConfig struct {
+	Kind       string  `json:"kind"`              // cpu or memory
+	Goroutines int     `json:"goroutines"`        // 0 means one per CPU
+	CPUPercent float64 `json:"cpu_percent"`       // busy share of each goroutine, 0 means 100
+	SizeMB     int     `json:"size_mb,omitempty"` // buffer per goroutine for memory, 0 means 64, at most 16384 in total
+	Seconds    float64 `json:"seconds,omitempty"` // stop automatically after this long, 0 means until stopped
+}
+
+// normalize fills defaults and validates the configuration
+func (c *neighbourConfig) normalize() error {
+	if c.Kind == "" {
+		c.Kind = neighbourCPU
+	}
+	if c.Goroutines == 0 {
+		c.Goroutines = runtime.NumCPU()
+	}
+	if c.Goroutines < 0 || c.Goroutines > 4096 {
+		return fmt.Errorf("goroutines must be in [1, 4096], got %d", c.Goroutines)
+	}
+
+	switch c.Kind {
+	case neighbourCPU:
+		c.SizeMB = 0
+	case neighbourMemory:
+		if c.SizeMB == 0 {
+			c.SizeMB = 64
+		}
+		if c.SizeMB < 0 {
+			return fmt.Errorf("size_mb must be positive, got %d", c.SizeMB)
+		}
+		// Every goroutine allocates its own buffer
+		if total := c.SizeMB * c.Goroutines; total > maxNeighbourMemoryMB {
+			return fmt.Errorf("size_mb × goroutines must not exceed %d MB, got %d × %d = %d MB",
+				maxNeighbourMemoryMB, c.SizeMB, c.Goroutines, total)
+		}
+	default:
+		return fmt.Errorf("unknown neighbour kind: %s (supported: cpu, memory)", c.Kind)
+	}
+
+	if c.CPUPercent == 0 {
+		c.CPUPercent = 100
+	}
+	if c.CPUPercent < 0 || c.CPUPercent > 100 {
+		return fmt.Errorf("cpu_percent must be in (0, 100], got %v", c.CPUPercent)
+	}
+	if c.Seconds < 0 {
+		return fmt.Errorf("seconds must not be negative, got %v", c.Seconds)
+	}
+	return nil
+}
+
+// neighbourStatus is returned by GET /admin/neighbour
+type neighbourStatus struct {
+	Running   bool             `json:"running"`
+	Config    *neighbourConfig `json:"config,omitempty"`
+	StartedAt time.Time        `json:"started_at,omitzero"`
+	BusyMs    float64          `json:"busy_ms"` // total busy time of all goroutines since start
+}
+
+// neighbour generates controlled background contention for interference experiments
+type neighbour struct {
+	events *eventLog
+
+	mu      sync.Mutex
+	cfg     neighbourConfig
+	started time.Time
+	cancel  context.CancelFunc
+	done    chan struct{}
+	busyNs  *atomic.Int64
+}
+
+// newNeighbour creates an idle background load generator that records changes in events
+func newNeighbour(events *eventLog) *neighbour {
+	return &neighbour{events: events}
+}
+
+// start replaces any running background load with cfg
+func (n *neighbour) start(cfg neighbourConfig) error {
+	if err := cfg.normalize(); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.stopLocked()
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if cfg.Seconds > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), time.Duration(cfg.Seconds*float64(time.Second)))
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	done := make(chan struct{})
+	busyNs := &atomic.Int64{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Goroutines; i++ {
+		wg.Add(1)
+		go func(seed uint64) {
+			defer wg.Done()
+			runNeighbourWorker(ctx, cfg, seed, busyNs)
+		}(uint64(i) + 1)
+	}
+	go func() {
+		// Record the stop when the workers actually exit, including after a timeout
+		wg.Wait()
+		n.events.record(eventNeighbourStop, map[string]any{"busy_ms": float64(busyNs.Load()) / 1e6})
+		close(done)
+	}()
+
+	n.cfg = cfg
+	n.started = time.Now()
+	n.cancel = cancel
+	n.done = done
+	n.busyNs = busyNs
+
+	n.events.record(eventNeighbourStart, cfg)
+	return nil
+}
+
+// stop ends the background load, if any
+func (n *neighbour) stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.stopLocked()
+}
+
+// stopLocked cancels the workers and waits for them to exit. The caller must hold n.mu.
+func (n *neighbour) stopLocked() {
+	if n.cancel == nil {
+		return
+	}
+	n.cancel()
+	<-n.done
+	n.cancel = nil
+}
+
+// status reports whether background load is running and how much CPU it has used
+func (n *neighbour) status() neighbourStatus {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.cancel == nil {
+		return neighbourStatus{}
+	}
+
+	cfg := n.cfg
+	st := neighbourStatus{
+		Running:   true,
+		Config:    &cfg,
+		StartedAt: n.started,
+		BusyMs:    float64(n.busyNs.Load()) / 1e6,
+	}
+	select {
+	case <-n.done:
+		// Stopped by its own timeout
+		st.Running = false
+	default:
+	}
+	return st
+}
+
+// runNeighbourWorker burns CPU (or memory bandwidth) for cfg.CPUPercent of every period until ctx is done
+func runNeighbourWorker(ctx context.Context, cfg neighbourConfig, seed uint64, busyNs *atomic.Int64) {
+	var buf []uint64
+	if cfg.Kind == neighbourMemory {
+		buf = make([]uint64, cfg.SizeMB<<20/8)
+	}
+
+	busy := time.Duration(float64(neighbourPeriod) * cfg.CPUPercent / 100)
+	x := seed*0x9E3779B97F4A7C15 | 1
+
+	for ctx.Err() == nil {
+		start := time.Now()
+		for time.Since(start) < busy {
+			if buf == nil {
+				x = spin(x)
+			} else {
+				x = thrash(buf, x)
+			}
+		}
+		busyNs.Add(int64(time.Since(start)))
+
+		if idle := neighbourPeriod - time.Since(start); idle > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(idle):
+			}
+		}
+	}
+	neighbourSink.Add(x)
+}
+
+// spin runs a short xorshift loop that keeps one core busy without touching memory
+func spin(x uint64) uint64 {
+	for i := 0; i < 1024; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+	}
+	return x
+}
+
+// thrash writes to random cache lines of buf, defeating the caches and prefetchers
+func thrash(buf []uint64, x uint64) uint64 {
+	n := uint64(len(buf))
+	for i := 0; i < 256; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		buf[(x%n)&^7]++
+	}
+	return x
+}
+
+// registerNeighbour adds the background load endpoints to mux
+func registerNeighbour(mux *http.ServeMux, n *neighbour) {
+	mux.HandleFunc("GET /admin/neighbour", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, n.status())
+	})
+	mux.HandleFunc("PUT /admin/neighbour", func(w http.ResponseWriter, r *http.Request) {
+		var cfg neighbourConfig
+		if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+			http.Error(w, "请求体格式错误: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := n.start(cfg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusOK, n.status())
+	})
+	mux.HandleFunc("DELETE /admin/neighbour", func(w http.ResponseWriter, r *http.Request) {
+		n.stop()
+		writeJSON(w, http.StatusOK, n.status())
+	})
+}
+
+// runNeighbourMode runs only the background load API, so that the neighbour can live in
+// its own process (and cgroup) next to the cpusim server under test
+func runNeighbourMode(port int) {
+	events := &eventLog{}
+	n := newNeighbour(events)
+
+	mux := http.NewServeMux()
+	registerNeighbour(mux, n)
+	mux.Handle("GET /admin/events", events)
+
+	addr := fmt.Sprintf(":%d", port)
+	log.Printf("Neighbour模式: 监听端口 %s", addr)
+
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("服务启动失败: %v", err)
+	}
+}
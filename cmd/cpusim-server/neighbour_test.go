@@ -0,0 +1,115 @@
+package main
+
+import (
+	"net/http"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNeighbourConfigNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  neighbourConfig
+		want neighbourConfig
+		ok   bool
+	}{
+		{"defaults", neighbourConfig{},
+			neighbourConfig{Kind: neighbourCPU, Goroutines: runtime.NumCPU(), CPUPercent: 100}, true},
+		{"cpu ignores size", neighbourConfig{Kind: neighbourCPU, Goroutines: 2, CPUPercent: 50, SizeMB: 128},
+			neighbourConfig{Kind: neighbourCPU, Goroutines: 2, CPUPercent: 50}, true},
+		{"memory default size", neighbourConfig{Kind: neighbourMemory, Goroutines: 4},
+			neighbourConfig{Kind: neighbourMemory, Goroutines: 4, CPUPercent: 100, SizeMB: 64}, true},
+		{"memory at the total limit", neighbourConfig{Kind: neighbourMemory, Goroutines: 4, SizeMB: 4096},
+			neighbourConfig{Kind: neighbourMemory, Goroutines: 4, CPUPercent: 100, SizeMB: 4096}, true},
+		{"memory above the total limit", neighbourConfig{Kind: neighbourMemory, Goroutines: 4, SizeMB: 4097}, neighbourConfig{}, false},
+		{"many goroutines above the total limit", neighbourConfig{Kind: neighbourMemory, Goroutines: 4096}, neighbourConfig{}, false},
+		{"negative size", neighbourConfig{Kind: neighbourMemory, SizeMB: -1}, neighbourConfig{}, false},
+		{"unknown kind", neighbourConfig{Kind: "disk"}, neighbourConfig{}, false},
+		{"too many goroutines", neighbourConfig{Goroutines: 4097}, neighbourConfig{}, false},
+		{"cpu percent above 100", neighbourConfig{CPUPercent: 101}, neighbourConfig{}, false},
+		{"negative seconds", neighbourConfig{Seconds: -1}, neighbourConfig{}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := tc.cfg
+			err := cfg.normalize()
+			if !tc.ok {
+				if err == nil {
+					t.Errorf("normalize(%+v) accepted an invalid config", tc.cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalize(%+v) = %v", tc.cfg, err)
+			}
+			if cfg != tc.want {
+				t.Errorf("normalize(%+v) = %+v, want %+v", tc.cfg, cfg, tc.want)
+			}
+		})
+	}
+}
+
+func TestNeighbourStartStop(t *testing.T) {
+	events := &eventLog{}
+	n := newNeighbour(events)
+	mux := http.NewServeMux()
+	registerNeighbour(mux, n)
+
+	if rec := do(mux, http.MethodPut, "/admin/neighbour", `{"kind":"memory","goroutines":2,"size_mb":9000}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("start over the memory limit = %d, want 400", rec.Code)
+	}
+	if n.status().Running {
+		t.Fatal("an invalid config started the neighbour")
+	}
+
+	if rec := do(mux, http.MethodPut, "/admin/neighbour", `{"kind":"memory","goroutines":2,"cpu_percent":20,"size_mb":1}`); rec.Code != http.StatusOK {
+		t.Fatalf("start = %d %s", rec.Code, rec.Body.String())
+	}
+	time.Sleep(3 * neighbourPeriod)
+	st := n.status()
+	if !st.Running || st.Config.Goroutines != 2 || st.BusyMs <= 0 {
+		t.Errorf("status while running = %+v, want 2 busy goroutines", st)
+	}
+
+	// Starting again replaces the running load
+	if err := n.start(neighbourConfig{Goroutines: 1, CPUPercent: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if st := n.status(); st.Config.Kind != neighbourCPU || st.Config.Goroutines != 1 {
+		t.Errorf("status after restart = %+v, want 1 cpu goroutine", st)
+	}
+
+	if rec := do(mux, http.MethodDelete, "/admin/neighbour", ""); rec.Code != http.StatusOK {
+		t.Fatalf("stop = %d", rec.Code)
+	}
+	if n.status().Running {
+		t.Error("neighbour still running after stop")
+	}
+	n.stop() // stopping twice is a no-op
+
+	var types []string
+	for _, ev := range events.since(time.Time{}) {
+		types = append(types, ev.Type)
+	}
+	want := []string{eventNeighbourStart, eventNeighbourStop, eventNeighbourStart, eventNeighbourStop}
+	if !slices.Equal(types, want) {
+		t.Errorf("events = %v, want %v", types, want)
+	}
+}
+
+func TestNeighbourTimeout(t *testing.T) {
+	n := newNeighbour(&eventLog{})
+	if err := n.start(neighbourConfig{Goroutines: 1, CPUPercent: 10, Seconds: 0.02}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for n.status().Running {
+		if time.Now().After(deadline) {
+			t.Fatal("neighbour did not stop after its timeout")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	n.stop()
+}
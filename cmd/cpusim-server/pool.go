@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Queue disciplines supported by the worker pool
+const (
+	disciplineFIFO     = "fifo"
+	disciplineLIFO     = "lifo"
+	disciplinePriority = "priority"
+)
+
+// priorityHeader carries the request priority in priority mode, higher values are served first
+const priorityHeader = "X-Priority"
+
+// queueWaitKey is the request context key holding the time spent in the pool queue
+type queueWaitKey struct{}
+
+// queueWaitFrom returns the queueing delay stored in ctx by the pool middleware
+func queueWaitFrom(ctx context.Context) time.Duration {
+	wait, _ := ctx.Value(queueWaitKey{}).(time.Duration)
+	return wait
+}
+
+var errQueueFull = errors.New("queue full")
+
+// waiter is a request waiting in the pool queue for a free worker
+type waiter struct {
+	ready    chan struct{}
+	priority int
+}
+
+// workerPool emulates c identical servers with an explicit waiting queue, so that
+// queueing happens in a known discipline instead of inside the Go scheduler.
+// workers == 0 means unbounded concurrency (every request gets a worker immediately).
+type workerPool struct {
+	workers    int
+	maxQueue   int // 0 means unbounded
+	discipline string
+
+	mu    sync.Mutex
+	busy  int
+	queue []*waiter
+
+	// Counters, protected by mu
+	maxQueueLen int
+	served      int64
+	rejected    int64
+	abandoned   int64
+	totalWait   time.Duration
+	maxWait     time.Duration
+
+	// onWait, if set, is called with the queueing delay of every admitted request
+	onWait func(time.Duration)
+}
+
+// poolStats is a snapshot of the worker pool counters
+type poolStats struct {
+	Workers        int     `json:"workers"` // 0 means unbounded
+	Discipline     string  `json:"discipline"`
+	MaxQueue       int     `json:"max_queue"` // 0 means unbounded
+	Busy           int     `json:"busy"`
+	QueueLength    int     `json:"queue_length"`
+	MaxQueueLength int     `json:"max_queue_length"`
+	Served         int64   `json:"served"`    // requests that got a worker
+	Rejected       int64   `json:"rejected"`  // requests rejected with 503 because the queue was full
+	Abandoned      int64   `json:"abandoned"` // requests whose client gave up while queued
+	AvgWaitMs      float64 `json:"avg_wait_ms"`
+	MaxWaitMs      float64 `json:"max_wait_ms"`
+	TotalWaitMs    float64 `json:"total_wait_ms"`
+}
+
+// newWorkerPool creates a worker pool and validates its configuration
+func newWorkerPool(workers, maxQueue int, discipline string) (*workerPool, error) {
+	if workers < 0 {
+		return nil, fmt.Errorf("workers must not be negative, got %d", workers)
+	}
+	if maxQueue < 0 {
+		return nil, fmt.Errorf("max queue must not be negative, got %d", maxQueue)
+	}
+	switch discipline {
+	case disciplineFIFO, disciplineLIFO, disciplinePriority:
+	default:
+		return nil, fmt.Errorf("unknown queue discipline: %s (supported: fifo, lifo, priority)", discipline)
+	}
+
+	return &workerPool{
+		workers:    workers,
+		maxQueue:   maxQueue,
+		discipline: discipline,
+	}, nil
+}
+
+// middleware admits requests through the pool before calling next.
+// Requests are rejected with 503 when the queue is full.
+func (p *workerPool) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		priority, _ := strconv.Atoi(r.Header.Get(priorityHeader))
+
+		wait, err := p.acquire(r.Context(), priority)
+		if err != nil {
+			if errors.Is(err, errQueueFull) {
+				w.Header().Set("Retry-After", "1")
+				http.Error(w, "服务繁忙: 等待队列已满", http.StatusServiceUnavailable)
+			}
+			// Otherwise the client went away while queued, nothing to write
+			return
+		}
+		defer p.release()
+
+		if p.onWait != nil {
+			p.onWait(wait)
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), queueWaitKey{}, wait)))
+	})
+}
+
+// acquire blocks until a worker is available and returns the time spent queued
+func (p *workerPool) acquire(ctx context.Context, priority int) (time.Duration, error) {
+	start := time.Now()
+
+	p.mu.Lock()
+	if p.workers == 0 || (p.busy < p.workers && len(p.queue) == 0) {
+		p.busy++
+		p.served++
+		p.mu.Unlock()
+		return 0, nil
+	}
+	if p.maxQueue > 0 && len(p.queue) >= p.maxQueue {
+		p.rejected++
+		p.mu.Unlock()
+		return 0, errQueueFull
+	}
+
+	wt := &waiter{
+		ready:    make(chan struct{}),
+		priority: priority,
+	}
+	p.queue = append(p.queue, wt)
+	if len(p.queue) > p.maxQueueLen {
+		p.maxQueueLen = len(p.queue)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-wt.ready:
+		wait := time.Since(start)
+		p.mu.Lock()
+		p.served++
+		p.totalWait += wait
+		if wait > p.maxWait {
+			p.maxWait = wait
+		}
+		p.mu.Unlock()
+		return wait, nil
+
+	case <-ctx.Done():
+		p.mu.Lock()
+		if p.remove(wt) {
+			p.abandoned++
+			p.mu.Unlock()
+			return 0, ctx.Err()
+		}
+		p.mu.Unlock()
+
+		// A worker was handed to us concurrently, give it back
+		<-wt.ready
+		p.release()
+		return 0, ctx.Err()
+	}
+}
+
+// release frees a worker, handing it directly to the next queued request if any
+func (p *workerPool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.queue) == 0 {
+		p.busy--
+		return
+	}
+
+	// The worker stays busy and is passed to the dequeued request
+	next := p.queue[p.next()]
+	p.remove(next)
+	close(next.ready)
+}
+
+// next returns the queue index of the request to serve next according to the discipline.
+// The caller must hold p.mu and the queue must not be empty.
+func (p *workerPool) next() int {
+	switch p.discipline {
+	case disciplineLIFO:
+		return len(p.queue) - 1
+	case disciplinePriority:
+		// Highest priority first, FIFO among equal priorities (queue is in arrival order)
+		best := 0
+		for i, wt := range p.queue {
+			if wt.priority > p.queue[best].priority {
+				best = i
+			}
+		}
+		return best
+	default:
+		return 0
+	}
+}
+
+// remove deletes wt from the queue, keeping arrival order. It reports whether wt was queued.
+// The caller must hold p.mu.
+func (p *workerPool) remove(wt *waiter) bool {
+	for i, q := range p.queue {
+		if q == wt {
+			copy(p.queue[i:], p.queue[i+1:])
+			p.queue[len(p.queue)-1] = nil
+			p.queue = p.queue[:len(p.queue)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// stats returns a snapshot of the pool counters
+func (p *workerPool) stats() poolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := poolStats{
+		Workers:        p.workers,
+		Discipline:     p.discipline,
+		MaxQueue:       p.maxQueue,
+		Busy:           p.busy,
+		QueueLength:    len(p.queue),
+		MaxQueueLength: p.maxQueueLen,
+		Served:         p.served,
+		Rejected:       p.rejected,
+		Abandoned:      p.abandoned,
+		TotalWaitMs:    float64(p.totalWait.Nanoseconds()) / 1e6,
+		MaxWaitMs:      float64(p.maxWait.Nanoseconds()) / 1e6,
+	}
+	if p.served > 0 {
+		s.AvgWaitMs = s.TotalWaitMs / float64(p.served)
+	}
+	return s
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+// waitQueued waits until the pool queue holds n requests
+func waitQueued(t *testing.T, p *workerPool, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for p.stats().QueueLength != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("queue length = %d, want %d", p.stats().QueueLength, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWorkerPoolOrder(t *testing.T) {
+	// Requests arrive in order 0, 1, 2, 3 with these priorities
+	priorities := []int{1, 3, 2, 3}
+
+	for _, tc := range []struct {
+		discipline string
+		want       []int
+	}{
+		{disciplineFIFO, []int{0, 1, 2, 3}},
+		{disciplineLIFO, []int{3, 2, 1, 0}},
+		{disciplinePriority, []int{1, 3, 2, 0}}, // FIFO among equal priorities
+	} {
+		t.Run(tc.discipline, func(t *testing.T) {
+			p, err := newWorkerPool(1, 0, tc.discipline)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.acquire(context.Background(), 0); err != nil {
+				t.Fatal(err)
+			}
+
+			served := make(chan int)
+			for id, priority := range priorities {
+				go func() {
+					if _, err := p.acquire(context.Background(), priority); err != nil {
+						t.Error(err)
+						return
+					}
+					served <- id
+				}()
+				waitQueued(t, p, id+1)
+			}
+
+			// Each release hands the single worker to the next request of the discipline
+			var got []int
+			for range priorities {
+				p.release()
+				got = append(got, <-served)
+			}
+			p.release()
+
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("served order = %v, want %v", got, tc.want)
+			}
+			if st := p.stats(); st.Busy != 0 || st.Served != 5 || st.MaxQueueLength != 4 {
+				t.Errorf("stats = %+v, want 0 busy, 5 served, max queue 4", st)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolQueueFull(t *testing.T) {
+	p, err := newWorkerPool(1, 1, disciplineFIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.acquire(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	queued := make(chan error)
+	go func() {
+		_, err := p.acquire(context.Background(), 0)
+		queued <- err
+	}()
+	waitQueued(t, p, 1)
+
+	handler := p.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called for a request over the queue limit")
+	}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/calculate", nil))
+
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("full queue: status %d, Retry-After %q, want 503 with Retry-After", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if st := p.stats(); st.Rejected != 1 {
+		t.Errorf("rejected = %d, want 1", st.Rejected)
+	}
+
+	p.release()
+	if err := <-queued; err != nil {
+		t.Fatal(err)
+	}
+	p.release()
+}
+
+func TestWorkerPoolAbandoned(t *testing.T) {
+	p, err := newWorkerPool(1, 0, disciplineFIFO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.acquire(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	abandoned := make(chan error)
+	go func() {
+		_, err := p.acquire(ctx, 0)
+		abandoned <- err
+	}()
+	waitQueued(t, p, 1)
+	cancel()
+
+	if err := <-abandoned; !errors.Is(err, context.Canceled) {
+		t.Fatalf("acquire after cancel = %v, want context.Canceled", err)
+	}
+
+	// The abandoned request is dropped from the queue, so the worker becomes idle on release
+	st := p.stats()
+	if st.Abandoned != 1 || st.QueueLength != 0 {
+		t.Errorf("stats = %+v, want 1 abandoned and an empty queue", st)
+	}
+	p.release()
+	if st := p.stats(); st.Busy != 0 || st.Served != 1 {
+		t.Errorf("after release: stats = %+v, want 0 busy, 1 served", st)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+)
+
+// Protocols supported by the server
+const (
+	protocolH1  = "h1"  // HTTP/1.1 over TCP
+	protocolH2C = "h2c" // cleartext HTTP/2 over TCP (prior knowledge), HTTP/1.1 is still accepted
+	protocolH3  = "h3"  // HTTP/3 over QUIC, HTTP/1.1 stays available on TCP for /metrics and /admin
+)
+
+// validateProtocol checks the -protocol flag together with the TLS flags. h3 always runs over TLS:
+// it needs both -tls-cert and -tls-key, or neither for a generated self-signed certificate.
+// The other protocols are cleartext and do not accept TLS flags.
+func validateProtocol(protocol, certFile, keyFile string) error {
+	switch protocol {
+	case protocolH3:
+		if (certFile == "") != (keyFile == "") {
+			return fmt.Errorf("h3 needs both -tls-cert and -tls-key, or neither for a self-signed certificate")
+		}
+		return nil
+	case protocolH1, protocolH2C:
+		if certFile != "" || keyFile != "" {
+			return fmt.Errorf("-tls-cert and -tls-key are only used with h3, %s is cleartext", protocol)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown protocol: %s (supported: h1, h2c, h3)", protocol)
+	}
+}
+
+// serve listens on addr with the given protocol until the server fails.
+// certFile/keyFile are only used for h3; when empty a self-signed certificate is generated.
+func serve(addr string, handler http.Handler, protocol, certFile, keyFile string) error {
+	srv := &http.Server{Addr: addr, Handler: handler}
+
+	switch protocol {
+	case protocolH2C:
+		srv.Protocols = new(http.Protocols)
+		srv.Protocols.SetHTTP1(true)
+		srv.Protocols.SetUnencryptedHTTP2(true)
+		return srv.ListenAndServe()
+
+	case protocolH3:
+		tlsConf, err := loadTLSConfig(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+		h3 := &http3.Server{
+			Addr:      addr,
+			Handler:   handler,
+			TLSConfig: http3.ConfigureTLSConfig(tlsConf),
+		}
+
+		// QUIC 使用 UDP，同一端口的 TCP 上继续提供 HTTP/1.1，方便抓取指标和调用管理接口
+		errCh := make(chan error, 2)
+		go func() { errCh <- h3.ListenAndServe() }()
+		go func() { errCh <- srv.ListenAndServe() }()
+		return <-errCh
+
+	default:
+		return srv.ListenAndServe()
+	}
+}
+
+// loadTLSConfig loads the certificate pair, or generates a self-signed one when none is given
+func loadTLSConfig(certFile, keyFile string) (*tls.Config, error) {
+	var cert tls.Certificate
+	var err error
+	if certFile != "" || keyFile != "" {
+		cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+	} else {
+		log.Printf("未指定TLS证书，使用自签名证书（客户端需跳过证书校验）")
+		cert, err = selfSignedCert()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	return &tls.Config{Certificates: []tls.Certificate{cert}}, nil
+}
+
+// selfSignedCert generates a short-lived ECDSA certificate for localhost and all local addresses
+func selfSignedCert() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "cpusim-server"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+	}
+	if addrs, err := net.InterfaceAddrs(); err == nil {
+		for _, a := range addrs {
+			if ipNet, ok := a.(*net.IPNet); ok {
+				tmpl.IPAddresses = append(tmpl.IPAddresses, ipNet.IP)
+			}
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
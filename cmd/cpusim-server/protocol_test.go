@@ -0,0 +1,85 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		protocol, cert, key string
+		ok                  bool
+	}{
+		{protocolH1, "", "", true},
+		{protocolH2C, "", "", true},
+		{protocolH3, "", "", true}, // self-signed certificate
+		{protocolH3, "cert.pem", "key.pem", true},
+		{protocolH3, "cert.pem", "", false},
+		{protocolH3, "", "key.pem", false},
+		{protocolH1, "cert.pem", "key.pem", false},
+		{protocolH2C, "", "key.pem", false},
+		{"h2", "", "", false},
+		{"quic", "", "", false},
+		{"", "", "", false},
+	} {
+		err := validateProtocol(tc.protocol, tc.cert, tc.key)
+		if (err == nil) != tc.ok {
+			t.Errorf("validateProtocol(%q, %q, %q) = %v, want ok %v", tc.protocol, tc.cert, tc.key, err, tc.ok)
+		}
+	}
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	// Without files h3 still gets a certificate, generated for localhost
+	conf, err := loadTLSConfig("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.Certificates) != 1 {
+		t.Fatalf("self-signed config has %d certificates, want 1", len(conf.Certificates))
+	}
+	leaf, err := x509.ParseCertificate(conf.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := leaf.VerifyHostname("localhost"); err != nil {
+		t.Errorf("self-signed certificate: %v", err)
+	}
+
+	// A configured pair is loaded as is
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writePair(t, conf.Certificates[0], certFile, keyFile)
+	loaded, err := loadTLSConfig(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(loaded.Certificates[0].Certificate[0]) != string(conf.Certificates[0].Certificate[0]) {
+		t.Error("loadTLSConfig did not load the configured certificate")
+	}
+
+	if _, err := loadTLSConfig(filepath.Join(dir, "missing.pem"), keyFile); err == nil {
+		t.Error("loadTLSConfig accepted a missing certificate file")
+	}
+}
+
+// writePair writes cert as PEM certificate and key files
+func writePair(t *testing.T, cert tls.Certificate, certFile, keyFile string) {
+	t.Helper()
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key})
+	if err := os.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
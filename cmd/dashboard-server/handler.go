@@ -1,9 +1,11 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
+	"cpusim/calculator"
 	"cpusim/dashboard/api/generated"
 	"cpusim/pkg/dashboard"
 
@@ -15,9 +17,16 @@ import (
 type APIHandler struct {
 	service *dashboard.Service
 	config  dashboard.Config
+	profile *calculator.Profile // optional capacity profile, nil when not configured
 	logger  zerolog.Logger
 }
 
+// suggestedQPSSteps is the number of QPS points used when the range comes from the capacity profile
+const suggestedQPSSteps = 9
+
+// maxGroupQPS is the largest QPS value the experiment group API accepts
+const maxGroupQPS = 1000
+
 // GetServiceConfig implements getting the service configuration
 func (h *APIHandler) GetServiceConfig(c *gin.Context) {
 	response := generated.ServiceConfig{
@@ -274,6 +283,50 @@ func (h *APIHandler) StartExperimentGroup(c *gin.Context) {
 		return
 	}
 
+	if request.UseProfile {
+		if h.profile == nil {
+			c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+				Error:     "invalid_request",
+				Message:   "useProfile requires a capacity profile (CAPACITY_PROFILE)",
+				Timestamp: time.Now(),
+			})
+			return
+		}
+		if request.TargetWorkers < 0 {
+			c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+				Error:     "invalid_request",
+				Message:   fmt.Sprintf("targetWorkers must not be negative, got %d", request.TargetWorkers),
+				Timestamp: time.Now(),
+			})
+			return
+		}
+		// A worker-limited target cannot reach the peak throughput measured at higher concurrency
+		capacity := h.profile.Capacity(request.TargetWorkers) * float64(len(h.config.TargetHosts))
+		request.QpsMin, request.QpsMax, request.QpsStep = calculator.SuggestQPSRange(capacity, suggestedQPSSteps, maxGroupQPS)
+		h.logger.Info().
+			Str("group_id", request.GroupId).
+			Int("target_workers", request.TargetWorkers).
+			Float64("capacity", capacity).
+			Int("qps_min", request.QpsMin).
+			Int("qps_max", request.QpsMax).
+			Int("qps_step", request.QpsStep).
+			Msg("QPS range derived from capacity profile")
+	} else {
+		for _, qps := range []struct {
+			name  string
+			value int
+		}{{"qpsMin", request.QpsMin}, {"qpsMax", request.QpsMax}, {"qpsStep", request.QpsStep}} {
+			if qps.value < 1 || qps.value > maxGroupQPS {
+				c.JSON(http.StatusBadRequest, generated.ErrorResponse{
+					Error:     "invalid_request",
+					Message:   fmt.Sprintf("%s must be in [1, %d] unless useProfile is set, got %d", qps.name, maxGroupQPS, qps.value),
+					Timestamp: time.Now(),
+				})
+				return
+			}
+		}
+	}
+
 	// Create experiment group config with QPS range
 	config := dashboard.ExperimentGroupConfig{
 		QPSMin:       request.QpsMin,
@@ -282,6 +335,7 @@ func (h *APIHandler) StartExperimentGroup(c *gin.Context) {
 		RepeatCount:  request.RepeatCount,
 		Timeout:      request.Timeout,
 		DelayBetween: request.DelayBetween,
+		CPUBreakdown: request.CpuBreakdown,
 	}
 
 	// Start experiment group (this will run asynchronously)
@@ -526,14 +580,18 @@ func convertExperimentGroupToAPI(group dashboard.ExperimentGroup) generated.Expe
 			for hostName, stats := range qpsPoint.Statistics {
 				if stats != nil {
 					apiStatistics[hostName] = generated.CPUStats{
-						CpuMean:         float32(stats.CPUMean),
-						CpuStdDev:       float32(stats.CPUStdDev),
-						CpuConfLower:    float32(stats.CPUConfLower),
-						CpuConfUpper:    float32(stats.CPUConfUpper),
-						CpuMin:          float32(stats.CPUMin),
-						CpuMax:          float32(stats.CPUMax),
-						SampleSize:      stats.SampleSize,
-						ConfidenceLevel: float32(stats.ConfidenceLevel),
+						CpuMean:              float32(stats.CPUMean),
+						CpuStdDev:            float32(stats.CPUStdDev),
+						CpuConfLower:         float32(stats.CPUConfLower),
+						CpuConfUpper:         float32(stats.CPUConfUpper),
+						CpuMin:               float32(stats.CPUMin),
+						CpuMax:               float32(stats.CPUMax),
+						SampleSize:           stats.SampleSize,
+						ConfidenceLevel:      float32(stats.ConfidenceLevel),
+						HottestCoreMean:      float32(stats.HottestCoreMean),
+						ProcessCpuMean:       float32(stats.ProcessCPUMean),
+						ThrottledPeriodsMean: float32(stats.ThrottledPeriodsMean),
+						Breakdown:            cpuBreakdownToAPI(stats.Breakdown),
 					}
 				}
 			}
@@ -543,17 +601,18 @@ func convertExperimentGroupToAPI(group dashboard.ExperimentGroup) generated.Expe
 		var apiLatencyStats generated.LatencyStats
 		if qpsPoint.LatencyStats != nil {
 			apiLatencyStats = generated.LatencyStats{
-				LatencyP50:  float32(qpsPoint.LatencyStats.LatencyP50),
-				LatencyP90:  float32(qpsPoint.LatencyStats.LatencyP90),
-				LatencyP95:  float32(qpsPoint.LatencyStats.LatencyP95),
-				LatencyP99:  float32(qpsPoint.LatencyStats.LatencyP99),
-				LatencyMean: float32(qpsPoint.LatencyStats.LatencyMean),
-				LatencyMin:  float32(qpsPoint.LatencyStats.LatencyMin),
-				LatencyMax:  float32(qpsPoint.LatencyStats.LatencyMax),
-				Throughput:  float32(qpsPoint.LatencyStats.Throughput),
-				ErrorRate:   float32(qpsPoint.LatencyStats.ErrorRate),
-				Utilization: float32(qpsPoint.LatencyStats.Utilization),
-				SampleSize:  qpsPoint.LatencyStats.SampleSize,
+				LatencyP50:    float32(qpsPoint.LatencyStats.LatencyP50),
+				LatencyP90:    float32(qpsPoint.LatencyStats.LatencyP90),
+				LatencyP95:    float32(qpsPoint.LatencyStats.LatencyP95),
+				LatencyP99:    float32(qpsPoint.LatencyStats.LatencyP99),
+				LatencyMean:   float32(qpsPoint.LatencyStats.LatencyMean),
+				LatencyMin:    float32(qpsPoint.LatencyStats.LatencyMin),
+				LatencyMax:    float32(qpsPoint.LatencyStats.LatencyMax),
+				Throughput:    float32(qpsPoint.LatencyStats.Throughput),
+				ErrorRate:     float32(qpsPoint.LatencyStats.ErrorRate),
+				Utilization:   float32(qpsPoint.LatencyStats.Utilization),
+				SampleSize:    qpsPoint.LatencyStats.SampleSize,
+				CacheHitRatio: float32(qpsPoint.LatencyStats.CacheHitRatio),
 			}
 		}
 
@@ -576,6 +635,7 @@ func convertExperimentGroupToAPI(group dashboard.ExperimentGroup) generated.Expe
 			RepeatCount:  group.Config.RepeatCount,
 			Timeout:      group.Config.Timeout,
 			DelayBetween: group.Config.DelayBetween,
+			CpuBreakdown: group.Config.CPUBreakdown,
 		},
 		EnvironmentConfig: convertConfigToAPI(group.EnvironmentConfig),
 		QpsPoints:         apiQPSPoints,
@@ -586,3 +646,20 @@ func convertExperimentGroupToAPI(group dashboard.ExperimentGroup) generated.Expe
 		CurrentRun:        group.CurrentRun,
 	}
 }
+
+// cpuBreakdownToAPI converts an aggregated CPU time split, nil stays nil
+func cpuBreakdownToAPI(b *dashboard.CPUBreakdown) *generated.CPUBreakdown {
+	if b == nil {
+		return nil
+	}
+	return &generated.CPUBreakdown{
+		User:    float32(b.User),
+		Nice:    float32(b.Nice),
+		System:  float32(b.System),
+		Iowait:  float32(b.Iowait),
+		Irq:     float32(b.Irq),
+		Softirq: float32(b.Softirq),
+		Steal:   float32(b.Steal),
+		Idle:    float32(b.Idle),
+	}
+}
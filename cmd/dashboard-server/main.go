@@ -11,6 +11,7 @@ import (
 	"syscall"
 	"time"
 
+	"cpusim/calculator"
 	"cpusim/dashboard/api/generated"
 	"cpusim/pkg/dashboard"
 
@@ -51,10 +52,22 @@ func main() {
 		log.Fatalf("Failed to initialize sub-experiment clients: %v", err)
 	}
 
+	// Load the optional cpusim-server capacity profile (produced by "cpusim-server -mode calibrate")
+	var profile *calculator.Profile
+	if profilePath := getEnv("CAPACITY_PROFILE", ""); profilePath != "" {
+		profile, err = calculator.LoadProfile(profilePath)
+		if err != nil {
+			log.Fatalf("Failed to load capacity profile: %v", err)
+		}
+		log.Printf("Capacity profile loaded from %s: %s, peak %.2f req/s per host",
+			profilePath, profile.Kind, profile.PeakThroughput)
+	}
+
 	// Create API handler
 	apiHandler := &APIHandler{
 		service: service,
 		config:  *config,
+		profile: profile,
 		logger:  logger,
 	}
 
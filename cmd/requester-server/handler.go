@@ -174,10 +174,25 @@ func (h *APIHandler) StopRequestExperiment(c *gin.Context, experimentId string)
 			MinResponseTime:     float32(data.Stats.MinResponseTime),
 			MaxResponseTime:     float32(data.Stats.MaxResponseTime),
 			ResponseTimeP50:     float32(data.Stats.P50),
+			ResponseTimeP90:     float32(data.Stats.P90),
 			ResponseTimeP95:     float32(data.Stats.P95),
 			ResponseTimeP99:     float32(data.Stats.P99),
 			RequestsPerSecond:   float32(data.Stats.ActualQPS),
 			ErrorRate:           float32(data.Stats.ErrorRate),
+			Throughput:          float32(data.Stats.Throughput),
+			Utilization:         float32(data.Stats.Utilization),
+			AverageServiceTime:  float32(data.Stats.AvgServiceTime),
+			ServiceTimeP50:      float32(data.Stats.ServiceTimeP50),
+			ServiceTimeP95:      float32(data.Stats.ServiceTimeP95),
+			ServiceTimeP99:      float32(data.Stats.ServiceTimeP99),
+			ServiceRate:         float32(data.Stats.ServiceRate),
+			AverageQueueTime:    float32(data.Stats.AvgQueueTime),
+			QueueTimeP50:        float32(data.Stats.QueueTimeP50),
+			QueueTimeP90:        float32(data.Stats.QueueTimeP90),
+			QueueTimeP99:        float32(data.Stats.QueueTimeP99),
+			CacheHits:           data.Stats.CacheHits,
+			CacheMisses:         data.Stats.CacheMisses,
+			CacheHitRatio:       float32(data.Stats.CacheHitRatio),
 			StartTime:           data.StartTime,
 			EndTime:             data.EndTime,
 			Duration:            int(data.Duration),
@@ -220,10 +235,25 @@ func (h *APIHandler) GetRequestExperimentStats(c *gin.Context, experimentId stri
 		MinResponseTime:     float32(data.Stats.MinResponseTime),
 		MaxResponseTime:     float32(data.Stats.MaxResponseTime),
 		ResponseTimeP50:     float32(data.Stats.P50),
+		ResponseTimeP90:     float32(data.Stats.P90),
 		ResponseTimeP95:     float32(data.Stats.P95),
 		ResponseTimeP99:     float32(data.Stats.P99),
 		RequestsPerSecond:   float32(data.Stats.ActualQPS),
 		ErrorRate:           float32(data.Stats.ErrorRate),
+		Throughput:          float32(data.Stats.Throughput),
+		Utilization:         float32(data.Stats.Utilization),
+		AverageServiceTime:  float32(data.Stats.AvgServiceTime),
+		ServiceTimeP50:      float32(data.Stats.ServiceTimeP50),
+		ServiceTimeP95:      float32(data.Stats.ServiceTimeP95),
+		ServiceTimeP99:      float32(data.Stats.ServiceTimeP99),
+		ServiceRate:         float32(data.Stats.ServiceRate),
+		AverageQueueTime:    float32(data.Stats.AvgQueueTime),
+		QueueTimeP50:        float32(data.Stats.QueueTimeP50),
+		QueueTimeP90:        float32(data.Stats.QueueTimeP90),
+		QueueTimeP99:        float32(data.Stats.QueueTimeP99),
+		CacheHits:           data.Stats.CacheHits,
+		CacheMisses:         data.Stats.CacheMisses,
+		CacheHitRatio:       float32(data.Stats.CacheHitRatio),
 		StartTime:           data.StartTime,
 		EndTime:             data.EndTime,
 		Duration:            int(data.Duration),
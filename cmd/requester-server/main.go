@@ -25,6 +25,8 @@ const (
 	defaultTimeout        = "30"
 	defaultStoragePath    = "./data/requester"
 	defaultArrivalPattern = "uniform" // "uniform" or "poisson"
+	defaultProtocol       = "h1"      // "h1", "h2c", "h3" or "grpc"
+	defaultGRPCPoolSize   = "1"       // gRPC connections to the target, only used with PROTOCOL=grpc
 )
 
 func main() {
@@ -39,6 +41,11 @@ func main() {
 	qps, _ := strconv.Atoi(getEnv("QPS", defaultQPS))
 	timeout, _ := strconv.Atoi(getEnv("TIMEOUT", defaultTimeout))
 	arrivalPatternStr := getEnv("ARRIVAL_PATTERN", defaultArrivalPattern)
+	workSize, _ := strconv.Atoi(getEnv("WORK_SIZE", "0"))
+	iterations, _ := strconv.Atoi(getEnv("ITERATIONS", "0"))
+	grpcPoolSize, _ := strconv.Atoi(getEnv("GRPC_POOL_SIZE", defaultGRPCPoolSize))
+	cacheKeys, _ := strconv.Atoi(getEnv("CACHE_KEYS", "0"))
+	cacheKeyZipf, _ := strconv.ParseFloat(getEnv("CACHE_KEY_ZIPF", "0"), 64)
 
 	// Parse arrival pattern
 	var arrivalPattern requester.ArrivalPattern
@@ -54,6 +61,13 @@ func main() {
 		QPS:            qps,
 		Timeout:        timeout,
 		ArrivalPattern: arrivalPattern,
+		Protocol:       requester.Protocol(getEnv("PROTOCOL", defaultProtocol)),
+		Workload:       getEnv("WORKLOAD", ""),
+		WorkSize:       workSize,
+		Iterations:     iterations,
+		GRPCPoolSize:   grpcPoolSize,
+		CacheKeys:      cacheKeys,
+		CacheKeyZipf:   cacheKeyZipf,
 	}
 
 	storagePath := getEnv("STORAGE_PATH", defaultStoragePath)
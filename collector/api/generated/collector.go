@@ -58,6 +58,86 @@ const (
 	ListExperimentsParamsStatusTimeout ListExperimentsParamsStatus = "timeout"
 )
 
+// Defines values for ListExperimentsParamsSortBy.
+const (
+	CpuMean   ListExperimentsParamsSortBy = "cpuMean"
+	EndTime   ListExperimentsParamsSortBy = "endTime"
+	Id        ListExperimentsParamsSortBy = "id"
+	StartTime ListExperimentsParamsSortBy = "startTime"
+)
+
+// Defines values for ListExperimentsParamsSortOrder.
+const (
+	Asc  ListExperimentsParamsSortOrder = "asc"
+	Desc ListExperimentsParamsSortOrder = "desc"
+)
+
+// CPUBreakdown Share of all CPU time spent in each state since the previous data point, in percent.
+// The fields add up to 100. Absent on the first data point.
+type CPUBreakdown struct {
+	Idle float32 `json:"idle"`
+
+	// Iowait Idle time with outstanding disk I/O
+	Iowait float32 `json:"iowait"`
+	Irq    float32 `json:"irq"`
+	Nice   float32 `json:"nice"`
+
+	// Softirq Deferred interrupt work, mostly kernel networking
+	Softirq float32 `json:"softirq"`
+
+	// Steal Time taken by the hypervisor for other guests
+	Steal  float32 `json:"steal"`
+	System float32 `json:"system"`
+	User   float32 `json:"user"`
+}
+
+// CgroupMetrics cgroup v2 metrics of the calculator service, absent when no cgroup is configured. Inside a
+// container these show the CPU the service actually got and whether its quota throttled it.
+type CgroupMetrics struct {
+	// CpuLimitCores CPU quota from cpu.max in cores, 0 when unlimited
+	CpuLimitCores float32 `json:"cpuLimitCores"`
+
+	// CpuUsagePercent CPU usage since the previous data point from cpu.stat, 100 means one core fully busy
+	CpuUsagePercent    float32 `json:"cpuUsagePercent"`
+	MemoryCurrentBytes int64   `json:"memoryCurrentBytes"`
+
+	// MemoryEvents Cumulative memory.events counters, absent when the memory controller is not enabled
+	MemoryEvents *MemoryEvents `json:"memoryEvents,omitempty"`
+
+	// MemoryMaxBytes memory.max, 0 when unlimited
+	MemoryMaxBytes int64 `json:"memoryMaxBytes"`
+
+	// NrPeriods Quota enforcement periods so far
+	NrPeriods int64 `json:"nrPeriods"`
+
+	// NrThrottled Periods in which the quota ran out so far
+	NrThrottled int64 `json:"nrThrottled"`
+
+	// Path cgroup path relative to the cgroup mount
+	Path string `json:"path"`
+
+	// ThrottledPeriodsPercent Share of the periods since the previous data point that were throttled
+	ThrottledPeriodsPercent float32 `json:"throttledPeriodsPercent"`
+
+	// ThrottledUsec Total time throttled in microseconds
+	ThrottledUsec int64 `json:"throttledUsec"`
+}
+
+// DiskIO I/O of one block device from /proc/diskstats, as rates per second since the previous data point (zero on the first one)
+type DiskIO struct {
+	// BusyPercent Share of the time the device had I/O in flight
+	BusyPercent     float32 `json:"busyPercent"`
+	Name            string  `json:"name"`
+	ReadBytesPerSec float64 `json:"readBytesPerSec"`
+
+	// ReadIops Completed reads per second
+	ReadIops         float32 `json:"readIops"`
+	WriteBytesPerSec float64 `json:"writeBytesPerSec"`
+
+	// WriteIops Completed writes per second
+	WriteIops float32 `json:"writeIops"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Details Additional error details
@@ -73,7 +153,7 @@ type ErrorResponse struct {
 
 // ExperimentData defines model for ExperimentData.
 type ExperimentData struct {
-	// CollectionInterval Collection interval in milliseconds
+	// CollectionInterval Collection interval in milliseconds. Earlier versions reported it in seconds; experiments stored by them are converted to milliseconds
 	CollectionInterval int    `json:"collectionInterval,omitempty"`
 	Description        string `json:"description,omitempty"`
 
@@ -109,13 +189,18 @@ type ExperimentResponseStatus string
 
 // ExperimentSummary defines model for ExperimentSummary.
 type ExperimentSummary struct {
+	// CpuMean Mean CPU usage percentage (without the first data point, which has no baseline), 0 while running
+	CpuMean float32 `json:"cpuMean,omitempty"`
+
 	// DataPointsCollected Number of metric data points collected
 	DataPointsCollected int    `json:"dataPointsCollected,omitempty"`
 	Description         string `json:"description,omitempty"`
 
 	// Duration Duration in seconds
-	Duration     int                     `json:"duration,omitempty"`
-	EndTime      time.Time               `json:"endTime,omitempty"`
+	Duration int `json:"duration,omitempty"`
+
+	// EndTime Absent while running
+	EndTime      *time.Time              `json:"endTime,omitempty"`
 	ExperimentId string                  `json:"experimentId"`
 	IsActive     bool                    `json:"isActive"`
 	StartTime    time.Time               `json:"startTime"`
@@ -137,10 +222,82 @@ type HealthResponse struct {
 // HealthResponseStatus defines model for HealthResponse.Status.
 type HealthResponseStatus string
 
+// InterfaceIO Network I/O of one interface as rates per second since the previous data point (zero on the first one).
+type InterfaceIO struct {
+	BytesReceived int64 `json:"bytesReceived"`
+	BytesSent     int64 `json:"bytesSent"`
+
+	// DropsInPerSec Received packets dropped per second
+	DropsInPerSec float64 `json:"dropsInPerSec"`
+
+	// DropsOutPerSec Transmitted packets dropped per second
+	DropsOutPerSec float64 `json:"dropsOutPerSec"`
+
+	// ErrorsInPerSec Receive errors per second
+	ErrorsInPerSec float64 `json:"errorsInPerSec"`
+
+	// ErrorsOutPerSec Transmit errors per second
+	ErrorsOutPerSec float64 `json:"errorsOutPerSec"`
+	Name            string  `json:"name"`
+	PacketsReceived int64   `json:"packetsReceived"`
+	PacketsSent     int64   `json:"packetsSent"`
+}
+
+// LiveDataResponse defines model for LiveDataResponse.
+type LiveDataResponse struct {
+	ExperimentId string `json:"experimentId"`
+
+	// FirstSeq Sequence number of the first returned point, the others follow without gaps
+	FirstSeq int               `json:"firstSeq"`
+	Metrics  []MetricDataPoint `json:"metrics"`
+
+	// Running Whether the experiment is still collecting
+	Running bool `json:"running"`
+}
+
+// MemoryDetail Memory activity from /proc/vmstat. Rates are per second since the previous data point (zero on the first one).
+type MemoryDetail struct {
+	// DirtyBytes Page cache waiting to be written back
+	DirtyBytes int64 `json:"dirtyBytes"`
+
+	// MajorFaultsPerSec Page faults that had to read from disk
+	MajorFaultsPerSec float32 `json:"majorFaultsPerSec"`
+	MinorFaultsPerSec float32 `json:"minorFaultsPerSec"`
+	PageCacheBytes    int64   `json:"pageCacheBytes"`
+
+	// SwapInPerSec Pages swapped in per second
+	SwapInPerSec float32 `json:"swapInPerSec"`
+
+	// SwapOutPerSec Pages swapped out per second
+	SwapOutPerSec  float32 `json:"swapOutPerSec"`
+	WritebackBytes int64   `json:"writebackBytes"`
+}
+
+// MemoryEvents Cumulative memory.events counters, absent when the memory controller is not enabled
+type MemoryEvents struct {
+	// High Reclaims forced by memory.high
+	High    int64 `json:"high"`
+	Low     int64 `json:"low"`
+	Max     int64 `json:"max"`
+	Oom     int64 `json:"oom"`
+	OomKill int64 `json:"oomKill"`
+}
+
 // MetricDataPoint defines model for MetricDataPoint.
 type MetricDataPoint struct {
-	SystemMetrics SystemMetrics `json:"systemMetrics"`
-	Timestamp     time.Time     `json:"timestamp"`
+	// CgroupMetrics cgroup v2 metrics of the calculator service, absent when no cgroup is configured. Inside a
+	// container these show the CPU the service actually got and whether its quota throttled it.
+	CgroupMetrics *CgroupMetrics `json:"cgroupMetrics,omitempty"`
+
+	// ProcessMetrics The calculator process(es) matched by name, summed over all matching processes. Rates are per
+	// second since the previous data point. Absent when no process name is configured.
+	ProcessMetrics *ProcessMetrics `json:"processMetrics,omitempty"`
+	SystemMetrics  SystemMetrics   `json:"systemMetrics"`
+
+	// TcpMetrics Kernel TCP health of the collector's network namespace. Retransmits and listen-queue overflows
+	// explain tail latency that CPU usage alone does not.
+	TcpMetrics *TCPMetrics `json:"tcpMetrics,omitempty"`
+	Timestamp  time.Time   `json:"timestamp"`
 }
 
 // NetworkIO defines model for NetworkIO.
@@ -158,13 +315,123 @@ type NetworkIO struct {
 	PacketsSent int64 `json:"packetsSent"`
 }
 
+// Pressure Pressure stall information from /proc/pressure, absent when the kernel does not provide it
+type Pressure struct {
+	// Full Share of time in percent that tasks were stalled, averaged over 10s, 60s and 300s.
+	// "some" means at least one task was stalled, "full" that all non-idle tasks were.
+	Full *PressureStall `json:"full,omitempty"`
+
+	// Some Share of time in percent that tasks were stalled, averaged over 10s, 60s and 300s.
+	// "some" means at least one task was stalled, "full" that all non-idle tasks were.
+	Some PressureStall `json:"some"`
+}
+
+// PressureStall Share of time in percent that tasks were stalled, averaged over 10s, 60s and 300s.
+// "some" means at least one task was stalled, "full" that all non-idle tasks were.
+type PressureStall struct {
+	Avg10  float32 `json:"avg10"`
+	Avg300 float32 `json:"avg300"`
+	Avg60  float32 `json:"avg60"`
+
+	// TotalUs Total stall time in microseconds
+	TotalUs int64 `json:"totalUs"`
+}
+
+// ProcessMetrics The calculator process(es) matched by name, summed over all matching processes. Rates are per
+// second since the previous data point. Absent when no process name is configured.
+type ProcessMetrics struct {
+	// CpuPercent CPU usage of the processes, 100 means one core fully busy
+	CpuPercent float32 `json:"cpuPercent"`
+
+	// HostCpuPercent cpuPercent spread over all cores, comparable with cpuUsagePercent
+	HostCpuPercent float32 `json:"hostCpuPercent"`
+
+	// InvoluntaryCtxSwitchesPerSec Context switches from preemption, a sign of CPU contention
+	InvoluntaryCtxSwitchesPerSec float32 `json:"involuntaryCtxSwitchesPerSec"`
+
+	// OpenFds Open file descriptors, including sockets
+	OpenFds int32 `json:"openFds"`
+
+	// Pids PIDs of the matching processes, empty while the service is down
+	Pids []int32 `json:"pids"`
+
+	// ReadBytesPerSec Bytes read from storage (0 without permission to read the process I/O counters)
+	ReadBytesPerSec float32 `json:"readBytesPerSec"`
+
+	// Restarted A tracked process exited and a new PID took its place since the previous data point
+	Restarted bool `json:"restarted"`
+
+	// Restarts Restarts since the collection started
+	Restarts int `json:"restarts"`
+
+	// RssBytes Resident set size in bytes
+	RssBytes int64 `json:"rssBytes"`
+	Threads  int32 `json:"threads"`
+
+	// VoluntaryCtxSwitchesPerSec Context switches from blocking on I/O, locks or sleeps
+	VoluntaryCtxSwitchesPerSec float32 `json:"voluntaryCtxSwitchesPerSec"`
+
+	// WriteBytesPerSec Bytes written to storage
+	WriteBytesPerSec float32 `json:"writeBytesPerSec"`
+}
+
+// RunQueueWait Time tasks spent runnable but waiting for a CPU since the previous data point, from /proc/schedstat.
+// Absent on the first data point and on kernels without schedstats.
+type RunQueueWait struct {
+	// PerTimesliceMs Average wait before each timeslice in milliseconds
+	PerTimesliceMs float32 `json:"perTimesliceMs"`
+
+	// WaitingTasks Average number of tasks waiting for a CPU
+	WaitingTasks float32 `json:"waitingTasks"`
+}
+
+// SchedulerMetrics Scheduler pressure, keeps growing after cpuUsagePercent saturates at 100
+type SchedulerMetrics struct {
+	// CpuPressure Pressure stall information from /proc/pressure, absent when the kernel does not provide it
+	CpuPressure *Pressure `json:"cpuPressure,omitempty"`
+
+	// IoPressure Pressure stall information from /proc/pressure, absent when the kernel does not provide it
+	IoPressure *Pressure `json:"ioPressure,omitempty"`
+	Load1      float32   `json:"load1"`
+	Load15     float32   `json:"load15"`
+	Load5      float32   `json:"load5"`
+
+	// MemoryPressure Pressure stall information from /proc/pressure, absent when the kernel does not provide it
+	MemoryPressure *Pressure `json:"memoryPressure,omitempty"`
+
+	// ProcsBlocked Tasks blocked on I/O
+	ProcsBlocked int `json:"procsBlocked"`
+
+	// ProcsRunning Runnable tasks, a value above the CPU count means tasks queue for a CPU
+	ProcsRunning int `json:"procsRunning"`
+
+	// RunQueueWait Time tasks spent runnable but waiting for a CPU since the previous data point, from /proc/schedstat.
+	// Absent on the first data point and on kernels without schedstats.
+	RunQueueWait *RunQueueWait `json:"runQueueWait,omitempty"`
+}
+
 // ServiceConfig 服务全局配置
 type ServiceConfig struct {
+	// CalculatorPort Calculator监听端口，用于统计该端口的TCP连接状态，0表示不统计
+	CalculatorPort int `json:"calculatorPort,omitempty"`
+
 	// CalculatorProcess 要监控的Calculator进程名
 	CalculatorProcess string `json:"calculatorProcess,omitempty"`
 
-	// CollectionInterval 数据采集间隔（秒）
+	// CgroupPath 监控的 cgroup v2 路径（相对 cgroup 挂载点），auto 表示跟随 Calculator 进程所在的 cgroup，空表示不采集
+	CgroupPath string `json:"cgroupPath,omitempty"`
+
+	// CollectionInterval 数据采集间隔（毫秒）。早期版本此字段以秒为单位
 	CollectionInterval int `json:"collectionInterval,omitempty"`
+
+	// NetworkExclude 排除的网卡名或通配符，在 networkInclude 之后应用
+	NetworkExclude []string `json:"networkExclude,omitempty"`
+
+	// NetworkInclude 统计的网卡名或通配符，空表示全部网卡
+	NetworkInclude []string `json:"networkInclude,omitempty"`
+
+	// ProcRoot 读取全部主机和进程指标的 procfs 挂载点，包括 CPU、内存、网络、被监控进程、调度器（loadavg、PSI、schedstat）、TCP（/proc/net）、磁盘（diskstats）和 vmstat
+	ProcRoot string `json:"procRoot,omitempty"`
 }
 
 // StartExperimentRequest defines model for StartExperimentRequest.
@@ -196,15 +463,74 @@ type SystemMetrics struct {
 	// CalculatorServiceHealthy Whether calculator service is responding
 	CalculatorServiceHealthy bool `json:"calculatorServiceHealthy"`
 
+	// CpuBreakdown Share of all CPU time spent in each state since the previous data point, in percent.
+	// The fields add up to 100. Absent on the first data point.
+	CpuBreakdown *CPUBreakdown `json:"cpuBreakdown,omitempty"`
+
 	// CpuUsagePercent CPU usage percentage
 	CpuUsagePercent float32 `json:"cpuUsagePercent"`
 
+	// Disks I/O of every active block device by name, partitions are listed alongside their disk
+	Disks []DiskIO `json:"disks,omitempty"`
+
+	// Interfaces Network I/O of every selected interface, networkIOBytes is their sum
+	Interfaces []InterfaceIO `json:"interfaces,omitempty"`
+
+	// MaxCpuUsagePercent Usage percentage of the hottest CPU, reveals single-core saturation hidden by the average
+	MaxCpuUsagePercent float32 `json:"maxCpuUsagePercent,omitempty"`
+
+	// MemoryDetail Memory activity from /proc/vmstat. Rates are per second since the previous data point (zero on the first one).
+	MemoryDetail *MemoryDetail `json:"memoryDetail,omitempty"`
+
 	// MemoryUsageBytes Memory usage in bytes
 	MemoryUsageBytes int64 `json:"memoryUsageBytes"`
 
 	// MemoryUsagePercent Memory usage percentage
 	MemoryUsagePercent float32   `json:"memoryUsagePercent"`
 	NetworkIOBytes     NetworkIO `json:"networkIOBytes"`
+
+	// PerCpuUsagePercent Usage percentage of every logical CPU, in CPU order (empty on the first data point)
+	PerCpuUsagePercent []float32 `json:"perCpuUsagePercent,omitempty"`
+
+	// Scheduler Scheduler pressure, keeps growing after cpuUsagePercent saturates at 100
+	Scheduler *SchedulerMetrics `json:"scheduler,omitempty"`
+}
+
+// TCPCounters /proc/net/snmp and /proc/net/netstat counters since the previous data point, absent on the
+// first one
+type TCPCounters struct {
+	ActiveOpens  int64 `json:"activeOpens"`
+	AttemptFails int64 `json:"attemptFails"`
+	EstabResets  int64 `json:"estabResets"`
+	InErrs       int64 `json:"inErrs"`
+	InSegs       int64 `json:"inSegs"`
+	ListenDrops  int64 `json:"listenDrops"`
+
+	// ListenOverflows Connections dropped because an accept queue was full
+	ListenOverflows int64 `json:"listenOverflows"`
+	OutRsts         int64 `json:"outRsts"`
+	OutSegs         int64 `json:"outSegs"`
+	PassiveOpens    int64 `json:"passiveOpens"`
+
+	// RetransSegs Segments retransmitted
+	RetransSegs int64 `json:"retransSegs"`
+
+	// TcpTimeouts Retransmission timer expirations
+	TcpTimeouts int64 `json:"tcpTimeouts"`
+}
+
+// TCPMetrics Kernel TCP health of the collector's network namespace. Retransmits and listen-queue overflows
+// explain tail latency that CPU usage alone does not.
+type TCPMetrics struct {
+	// Counters /proc/net/snmp and /proc/net/netstat counters since the previous data point, absent on the
+	// first one
+	Counters *TCPCounters `json:"counters,omitempty"`
+
+	// ListenBacklog Connections waiting in the accept queue of the calculator port's listening sockets
+	ListenBacklog int `json:"listenBacklog"`
+
+	// PortStates Sockets on the calculator port by state (ESTABLISHED, TIME_WAIT, ...), absent when no port is configured
+	PortStates map[string]int `json:"portStates,omitempty"`
 }
 
 // ListExperimentsParams defines parameters for ListExperiments.
@@ -214,11 +540,38 @@ type ListExperimentsParams struct {
 
 	// Limit Maximum number of experiments to return
 	Limit int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of matching experiments to skip
+	Offset int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// SortBy Field to sort by
+	SortBy ListExperimentsParamsSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Sort order
+	SortOrder ListExperimentsParamsSortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+
+	// StartedAfter Only experiments started at or after this time
+	StartedAfter time.Time `form:"startedAfter,omitempty" json:"startedAfter,omitempty"`
+
+	// StartedBefore Only experiments started before this time
+	StartedBefore time.Time `form:"startedBefore,omitempty" json:"startedBefore,omitempty"`
 }
 
 // ListExperimentsParamsStatus defines parameters for ListExperiments.
 type ListExperimentsParamsStatus string
 
+// ListExperimentsParamsSortBy defines parameters for ListExperiments.
+type ListExperimentsParamsSortBy string
+
+// ListExperimentsParamsSortOrder defines parameters for ListExperiments.
+type ListExperimentsParamsSortOrder string
+
+// GetLatestExperimentDataParams defines parameters for GetLatestExperimentData.
+type GetLatestExperimentDataParams struct {
+	// N Maximum number of points to return
+	N int `form:"n,omitempty" json:"n,omitempty"`
+}
+
 // StartExperimentJSONRequestBody defines body for StartExperiment for application/json ContentType.
 type StartExperimentJSONRequestBody = StartExperimentRequest
 
@@ -309,9 +662,15 @@ type ClientInterface interface {
 	// GetExperimentData request
 	GetExperimentData(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLatestExperimentData request
+	GetLatestExperimentData(ctx context.Context, experimentId string, params *GetLatestExperimentDataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// StopExperiment request
 	StopExperiment(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamExperimentData request
+	StreamExperimentData(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -379,6 +738,18 @@ func (c *Client) GetExperimentData(ctx context.Context, experimentId string, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetLatestExperimentData(ctx context.Context, experimentId string, params *GetLatestExperimentDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLatestExperimentDataRequest(c.Server, experimentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) StopExperiment(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewStopExperimentRequest(c.Server, experimentId)
 	if err != nil {
@@ -391,6 +762,18 @@ func (c *Client) StopExperiment(ctx context.Context, experimentId string, reqEdi
 	return c.Client.Do(req)
 }
 
+func (c *Client) StreamExperimentData(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamExperimentDataRequest(c.Server, experimentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
@@ -488,6 +871,66 @@ func NewListExperimentsRequest(server string, params *ListExperimentsParams) (*h
 			}
 		}
 
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, params.SortBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, params.SortOrder); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startedAfter", runtime.ParamLocationQuery, params.StartedAfter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startedBefore", runtime.ParamLocationQuery, params.StartedBefore); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -573,6 +1016,58 @@ func NewGetExperimentDataRequest(server string, experimentId string) (*http.Requ
 	return req, nil
 }
 
+// NewGetLatestExperimentDataRequest generates requests for GetLatestExperimentData
+func NewGetLatestExperimentDataRequest(server string, experimentId string, params *GetLatestExperimentDataParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "experimentId", runtime.ParamLocationPath, experimentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/experiments/%s/latest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "n", runtime.ParamLocationQuery, params.N); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewStopExperimentRequest generates requests for StopExperiment
 func NewStopExperimentRequest(server string, experimentId string) (*http.Request, error) {
 	var err error
@@ -607,6 +1102,40 @@ func NewStopExperimentRequest(server string, experimentId string) (*http.Request
 	return req, nil
 }
 
+// NewStreamExperimentDataRequest generates requests for StreamExperimentData
+func NewStreamExperimentDataRequest(server string, experimentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "experimentId", runtime.ParamLocationPath, experimentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/experiments/%s/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewHealthCheckRequest generates requests for HealthCheck
 func NewHealthCheckRequest(server string) (*http.Request, error) {
 	var err error
@@ -718,9 +1247,15 @@ type ClientWithResponsesInterface interface {
 	// GetExperimentDataWithResponse request
 	GetExperimentDataWithResponse(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*GetExperimentDataResponse, error)
 
+	// GetLatestExperimentDataWithResponse request
+	GetLatestExperimentDataWithResponse(ctx context.Context, experimentId string, params *GetLatestExperimentDataParams, reqEditors ...RequestEditorFn) (*GetLatestExperimentDataResponse, error)
+
 	// StopExperimentWithResponse request
 	StopExperimentWithResponse(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*StopExperimentResponse, error)
 
+	// StreamExperimentDataWithResponse request
+	StreamExperimentDataWithResponse(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*StreamExperimentDataResponse, error)
+
 	// HealthCheckWithResponse request
 	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
 
@@ -820,6 +1355,30 @@ func (r GetExperimentDataResponse) StatusCode() int {
 	return 0
 }
 
+type GetLatestExperimentDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LiveDataResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLatestExperimentDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLatestExperimentDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type StopExperimentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -843,6 +1402,28 @@ func (r StopExperimentResponse) StatusCode() int {
 	return 0
 }
 
+type StreamExperimentDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamExperimentDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamExperimentDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type HealthCheckResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -931,6 +1512,15 @@ func (c *ClientWithResponses) GetExperimentDataWithResponse(ctx context.Context,
 	return ParseGetExperimentDataResponse(rsp)
 }
 
+// GetLatestExperimentDataWithResponse request returning *GetLatestExperimentDataResponse
+func (c *ClientWithResponses) GetLatestExperimentDataWithResponse(ctx context.Context, experimentId string, params *GetLatestExperimentDataParams, reqEditors ...RequestEditorFn) (*GetLatestExperimentDataResponse, error) {
+	rsp, err := c.GetLatestExperimentData(ctx, experimentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLatestExperimentDataResponse(rsp)
+}
+
 // StopExperimentWithResponse request returning *StopExperimentResponse
 func (c *ClientWithResponses) StopExperimentWithResponse(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*StopExperimentResponse, error) {
 	rsp, err := c.StopExperiment(ctx, experimentId, reqEditors...)
@@ -940,6 +1530,15 @@ func (c *ClientWithResponses) StopExperimentWithResponse(ctx context.Context, ex
 	return ParseStopExperimentResponse(rsp)
 }
 
+// StreamExperimentDataWithResponse request returning *StreamExperimentDataResponse
+func (c *ClientWithResponses) StreamExperimentDataWithResponse(ctx context.Context, experimentId string, reqEditors ...RequestEditorFn) (*StreamExperimentDataResponse, error) {
+	rsp, err := c.StreamExperimentData(ctx, experimentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamExperimentDataResponse(rsp)
+}
+
 // HealthCheckWithResponse request returning *HealthCheckResponse
 func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
 	rsp, err := c.HealthCheck(ctx, reqEditors...)
@@ -1090,6 +1689,46 @@ func ParseGetExperimentDataResponse(rsp *http.Response) (*GetExperimentDataRespo
 	return response, nil
 }
 
+// ParseGetLatestExperimentDataResponse parses an HTTP response from a GetLatestExperimentDataWithResponse call
+func ParseGetLatestExperimentDataResponse(rsp *http.Response) (*GetLatestExperimentDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLatestExperimentDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LiveDataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseStopExperimentResponse parses an HTTP response from a StopExperimentWithResponse call
 func ParseStopExperimentResponse(rsp *http.Response) (*StopExperimentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1123,6 +1762,32 @@ func ParseStopExperimentResponse(rsp *http.Response) (*StopExperimentResponse, e
 	return response, nil
 }
 
+// ParseStreamExperimentDataResponse parses an HTTP response from a StreamExperimentDataWithResponse call
+func ParseStreamExperimentDataResponse(rsp *http.Response) (*StreamExperimentDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamExperimentDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
 func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1189,9 +1854,15 @@ type ServerInterface interface {
 	// Get experiment data
 	// (GET /experiments/{experimentId}/data)
 	GetExperimentData(c *gin.Context, experimentId string)
+	// Get the latest data points
+	// (GET /experiments/{experimentId}/latest)
+	GetLatestExperimentData(c *gin.Context, experimentId string, params GetLatestExperimentDataParams)
 	// Stop an experiment
 	// (POST /experiments/{experimentId}/stop)
 	StopExperiment(c *gin.Context, experimentId string)
+	// Stream data points live
+	// (GET /experiments/{experimentId}/stream)
+	StreamExperimentData(c *gin.Context, experimentId string)
 	// Health check
 	// (GET /health)
 	HealthCheck(c *gin.Context)
@@ -1246,6 +1917,46 @@ func (siw *ServerInterfaceWrapper) ListExperiments(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", c.Request.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortBy: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", c.Request.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortOrder: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startedAfter", c.Request.URL.Query(), &params.StartedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startedAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startedBefore", c.Request.URL.Query(), &params.StartedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startedBefore: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1293,6 +2004,41 @@ func (siw *ServerInterfaceWrapper) GetExperimentData(c *gin.Context) {
 	siw.Handler.GetExperimentData(c, experimentId)
 }
 
+// GetLatestExperimentData operation middleware
+func (siw *ServerInterfaceWrapper) GetLatestExperimentData(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "experimentId" -------------
+	var experimentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "experimentId", c.Param("experimentId"), &experimentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter experimentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLatestExperimentDataParams
+
+	// ------------- Optional query parameter "n" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "n", c.Request.URL.Query(), &params.N)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter n: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetLatestExperimentData(c, experimentId, params)
+}
+
 // StopExperiment operation middleware
 func (siw *ServerInterfaceWrapper) StopExperiment(c *gin.Context) {
 
@@ -1317,6 +2063,30 @@ func (siw *ServerInterfaceWrapper) StopExperiment(c *gin.Context) {
 	siw.Handler.StopExperiment(c, experimentId)
 }
 
+// StreamExperimentData operation middleware
+func (siw *ServerInterfaceWrapper) StreamExperimentData(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "experimentId" -------------
+	var experimentId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "experimentId", c.Param("experimentId"), &experimentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter experimentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamExperimentData(c, experimentId)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(c *gin.Context) {
 
@@ -1374,7 +2144,9 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/experiments", wrapper.ListExperiments)
 	router.POST(options.BaseURL+"/experiments", wrapper.StartExperiment)
 	router.GET(options.BaseURL+"/experiments/:experimentId/data", wrapper.GetExperimentData)
+	router.GET(options.BaseURL+"/experiments/:experimentId/latest", wrapper.GetLatestExperimentData)
 	router.POST(options.BaseURL+"/experiments/:experimentId/stop", wrapper.StopExperiment)
+	router.GET(options.BaseURL+"/experiments/:experimentId/stream", wrapper.StreamExperimentData)
 	router.GET(options.BaseURL+"/health", wrapper.HealthCheck)
 	router.GET(options.BaseURL+"/status", wrapper.GetStatus)
 }
@@ -1382,43 +2154,114 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZX29cRxX/KqOhD4m19q5Jidp9Qc7GNJY28cp24CEx1fjes7vT3DtzMzN3k21kKYiW",
-	"kNK0ARUVJRESPFALCRckhHhoyIeB9cZP/Qpo5s79P7tZUztFghfreu+ZOX/md37nnLn3sMfDiDNgSuL2",
-	"PSy9IYTEPK4LwcUWyIgzCfqHSPAIhKJgXvugCA3MI/F9qihnJOgVRJSIoYF9kJ6gkX6N23gtk0Sgt0fp",
-	"Lg2sxhHgNuZ774Gn8H4DG4FEU3ELYxbyuA/5IqkEZQO9KAQpyQDqy67EIWHLAohP9gKw2lNpx0aKhiAV",
-	"CSO9VZ+LkCjcxj5RsKxf1ZfsN7CA2zEV4OP2DWt9blBxx12Ht+t3IxA0BKYuE0Xq4fZ4EICnndlgCsSI",
-	"BHUfO5kMolYIUYZCGgRUgseZXwi0lhiA0LpLu9yrx8KPBUlfljVetm+0mnkagPk7OmoLxrKBIQvHhq9X",
-	"heRuF9hADXH74oUGDilL/11t4IgoBULb8+MbZPn91vLbu+fsw/LuUvrT+e+/4UaMEtQzMaYKQvPwhoA+",
-	"buPvNPPkaNrMaF418vqQepwyc3Z2TyIEGev/pSJCncTfKnaKzhe3y62dD6EulWp25ubbL+51vvd2HIZE",
-	"jF1+D4m8yoUj+X40BDUEgfQfQEQACrkAVDAEkRGhgU7NPDp7nAdAmNHElQvwO/pnxOJwDwTi/dKGIVHe",
-	"kLKBVoo8QRUIShzgnBl7g2Sjd36wFwn02YM4o73aO6mIihN7WBxqHw2gIIEWjyLzpIHJY1Xw9RS5sIZn",
-	"bdDijJhCrl6D0iyUlvrAr4PkWgaPJHmQXoUiswx52br/E2NFE5VrnqKjIqgKGXlijnMBUcSM6ZcuIKYN",
-	"wO5/iq4ibWa+uIB2BUighrPTuG730KwY4waOWfp8KonTwHGkbFDLgNoGMaIeoOT9fFhVArRgvlULWz0O",
-	"Y6kgvJrXy3kFY7skfAokkq9vVCxxOXMN1B0ubm1s1t3YGyuQW+ABHbno4pJ+jYR9jyIQNtK4kZtNmbr4",
-	"JjbpRkONiZYruY2mbUhi6dIigalvpCEi3i1Qc7zpJQKn44/V5vYo1fQNfaqcevmwiiGtO1820IUKm0Qd",
-	"zvp0UHfh6NmjyUe/m3x4MPnL/eMPH02fH+o9y204Cbw4IIqLnuAeSFnf5eUffjJ9+sujT76YPvmgk4m/",
-	"fPF0evCLyeNHmtjukjAKtGleFEsaLksQIxAuOlik7T/69Z+PHh0eP3hw/PRnx5//9fjJZ19/9fPpF7/6",
-	"+quHRWWrzmDXY6Rps9jd3I5BKtf8V6qNZZM2IzvlFX7WFVj3YjlfL1LfyvteZ/R2DIj6wBTtUxCoz0Vl",
-	"V3TuVrwHgoECuSzVOADESEjZ4LyG3pmVy7Ru1QfW3DIrU2BvdG51+cLFVsvalqSF/qGQJasnaFo33L1c",
-	"6XhVLGcXOy8WAlgBAK5j2LicHqYVD8bI1vLSQUAYqTGifcR48XcqU+nz8/uEynib6ELSlsKsrqVluQfM",
-	"T9qJLdtYvLJ5sHs4I1Utd7OYwLLKFdsIzJx+8hWZCzoS5iis3fU+y4vi67q174HwnLzb6V1HsZbQpKtF",
-	"ktuGjHT7ASeqiK7VErhyCk7GqGSaCLkYG7WmTtWVXjUSVi9lyNDyyQtKQdFM/0qqTs1FlvYHmYPzmpm8",
-	"m6jip3o8juA53axZ0JgNqDo4tRWU9XlyMcQU8UzgGNGdo0HENg31Xpp2e4KbVQ0ciwC38VCpSLabzQFV",
-	"w3hvxeNhc435Au6ERNHAh2ZSlGrzj00IO0XJZIyy9YknRKw1y1xzYZa+yW6ypaWkwCaltb20dJMto2LZ",
-	"+ud9WzmTYjn908NEdPLswJbmx19OPjo4+vxvx/efvHzxYPrJl5Pf/3Ty6W+OH3z68vAf0+eHesejh/eP",
-	"nj2cHP72+I8f/+v5i+lnB9Onf588/nj65INiadeixQ3aqLPZ7a53djY2r727cW1nfeuHa90G6qx1O9e7",
-	"azubW+/2tjY769vbN5mhWGUKuG6XUScLwlpvAzfwCIRMQra60lpp6UjyCBiJKG5j06aoocFc08s6kQE4",
-	"sP8OqCLLFqKdEkiyQTqGGj3Js6Ztvb7c8mjwJsxv9H+31UohZHOPRFFAPbND8z2ZlPYkC17Z8JcUGYi6",
-	"J5iyyVpOpvO9cVjOlGtWbq5mxoyggEqlaxQJgtKt0Dli5kBEmI+0EwEo8M/XAtelslACpTkzQUJQICRu",
-	"36jq/AENFIiSor1xXp+olrkdg9ATo03SfEzNoutDn8SBJjQSBIWytsiU3DBrXOWuxqcJSc64NVMcCVCx",
-	"YDPMDmhIldvq77VmMrCrg9k9QyzOuAl1gLJrcVJE1n4Dv3maxpS+ozhsuER8JGybXU4HY13FtIhL1zwJ",
-	"A8pSftBtmCyTtWZnghjcKbffZdRXWn+clDqQ6hL3x6dHFO4BY79cWpWIYf+1QGTe0RR6d3tpimTs6bmv",
-	"HwfB+NuFitb99uvTXYgFCQQQP+v5K6g1B1xHW5XBm/eKY8t+07ffvZysvqVxDCPIL2zLfYjBN5sH7ndA",
-	"Vb6xvYLUd8pTpSFAS4q6gOecWJm+yhguUuVZDZ+viUtN0OYDw5yhAeab3wowGVeoz2PmO/oKqJr5Cjzq",
-	"YmvGPifhbiseFfk2RaNuLCQZQQJLxZFUXCTzSpVseVTi2pOCce49x/8wTBfmc9NLOfj8vwu6BmhlajPI",
-	"Tb47zCTMzhC8W4jaW5ra3EAlyj9ilIGZjJ1m/VnOC5WvLnMGhoKt5dAkWyDPmGqCkl8dLTRPJeLZXVYt",
-	"SjqZCXPdbTlHrbSlP7sZq3x5NydmNhCzp6tMQEuY62cX7XS5Zy5xRxDwKEwv38xVdX6Z0G42Ay035FK1",
-	"32q91cI6098nNB96L6y0Vi7g/X8HAAD//zgtfCxuJAAA",
+	"H4sIAAAAAAAC/+R8bW8cx5HwX2nMEyCSsSSXlmUkzIcHEkXbRCSRIanHD87rc5oztbsdznSPunuWpAUC",
+	"kmPHUiL5DU6cyEJyzoutXGzLB9wljizFP8ZarvRJf+FQ3fM+s7tDUbYPFyCIqZ3q7urqqup67QuOK4JQ",
+	"cOBaOQsXHOX2IaDmz8XVcycl0C1PbHP8twfKlSzUTHBnwVnvUwlEdAn1fbK4eo5oFgBRIXBNGCdA3T5R",
+	"mmoginEXiO4DCSUMmIgU8aimJBSM6xYChyBd4Hq2wzf6QLoMfE8R6nkkCokWZL7dniUnNhVOLbiZqcuk",
+	"0rlpZjvcaTmhFCFIzcDgzzwf8L9dIQOqnQWn6wuqnZajd0NwFhweBZsgnb2Ww8Q2Zbq6x2XPB7uvbab7",
+	"RERaaco9xnvEY2qLLM+tOK0m88vzzRDhzG2IshJdHU9bxPkUdEFK8AjjGqSMQk22hdxqkUAo7e+SLZAc",
+	"fMJB48+M9xrtQGmgfnWxDaSNplvAyeauOZj+bghywJSQpCskEboPkvQiUFo1W2hXaQia0SBSIJtA7rUc",
+	"CecjJsFzFl6ww2JapwumTGBPKyNwsveW5acX09nF5k/ARfidmZ6YwR9n1BYLZ4QhDvVnDGMihl3qK9hr",
+	"OYs9KaLwDGjJXFUlpms+k8GTJLAgKF1IU5f6buRTLSRRSFwXWoRacdjuAydckHgsU8QVvMt6kQRvlixz",
+	"xTwgtMNdwTVlHCROqICovtg2cxvJ7UMyMaGujqjv75Ke0IRyD1cwZ8i0IucjoSnRfSm09pHFauXODaPT",
+	"LGB6UUio2SauaCfqShEQN4xmA7qDasDFAS3StruKuI+zgNeIb9wwOqdoD1atKqlfNkKIyfooQwqVVwt1",
+	"DwmAckUEB4Mh6UZIn81I7dZhFjDOgihwFto1WAYQCLm7GEkJXJ/c1ZY+6RyM66efynaH7NPLD1waJGr6",
+	"OxK6zoLzf+Yy7T0Xq+65M3nYdOwZupMuWKSM/Y6HMJn247HjchUkE17N5D8yJw28K6QLAbJsaEGJEqRL",
+	"ZdMFNhKmqy4Rr40ctN1nbt8creUwSTlq7QOtFVLdHyuc+JFI8KlmA8C7ycin/RaIiOd4U2mJ2nWv5aQC",
+	"E2M6lkfTO9UwZ0Knieyq+1STbZCQiWUtV9Idy5Xz7fYUHk3nOafArVH5QlPfXoo5RcBJwFwpFLiCe6oJ",
+	"oUtK2VC9KsatkjbJ81qRLcqIjyd7rRhWxOQwiv4UU1vLKzX2xNwKni5qkk1fuFvEA6N0jc6ZC6Vw59Cu",
+	"QM2jWoQqIqkGhZxALGWnMMORl0GKookkOBytaGjUXc2YMD5nSDDtUw+tHjzwrs96fX1IZuM0MAYP7NAg",
+	"9M23QQBtPl8nRxKoZw5nFeS65c10bU9Emz5M0784w7II624lgetr8AjC5Gl+YCW/LZmGw+FpppiGqAE6",
+	"BKYlCTRHUSVyzX5ydMyj2irwVUV+9lrOkpRCroEKBVfm2Its6YGmzDd/Us9jVrpWcyBaRtAq0eNECkkA",
+	"pyfJLDXrG4AqSQ1axBUe1HFdAAo1UnXYc1FA+QySgm76EK+eQNdMhLKkNA3CIkNQDTP4qTqkdEAW+wyh",
+	"/Iy11N7BKwTv3FNU0yq5XeH74OJmlrkGOagz8BdTGOtPDKhvlb3vs1jZz5IlKn0GkgxAKia4IhJCIbUx",
+	"EBE6BvwBgRQjRZQW6KVYtyEgqHNcwQdgxmlRWKL2ni4geqFKbi+SNPlYcpHiLzncalcA7qGD0/S4Wk62",
+	"v2VjpwR05zTwHtoTTx8zspj8c97YGRok4vOvL9CZl9sz33/xSPzHzItPJD8d/b/fqWfK1IlgGoIGNiHC",
+	"Ix+s4k3h7KVzUinprnXxqNQH2W+ZPfObz0+XYTuZS08zpccrh2z65rvO5l6PgoDK3bp996k6I2SNfD8f",
+	"ez74f2AYNEDzP8/EdECZT61Cj+fdFMIHys1KaCqNs6CsFsZ7Nj9hQLXbx/iCsSsl0yAZnW495YmTrDuZ",
+	"2E0I/fUzcapZK9+Upjqy+HC8vl6wDAWWtUQYmr+QMUWUv2weo7qt8DMi1FzpJixX1bthdAY5pMIY+CvJ",
+	"/NQ4KoZ/HsH4EzoydbGvVuz29KnCUMAmVeAzDketN8d8IDLivGmkx0t0hIp1f53LdTZlXivaOWwwApGM",
+	"+9bVdslUSGImY2gynjcOYPt/c+LD1AkX3dAcGXPK58DqvE7mMiJVZS4xp158VEHK3xDpXupk6jmgvu6P",
+	"11hVvPtmxK7TciKe/P1YdETLiUJdy1zrcQDNfp/MoyUCNVQtxkrrUhfqXMuzNp5Lci4mS+Afnyc5W3Ul",
+	"0S9YAxfYALwCFRPHv8YDyQmrGb8eO6IHHOtJEaplnnlZRZIkWJGQulugFUH4ELwcFZzWQT0zs+ZKpMct",
+	"uiEpVwHT+jGva2Rt+matF6Iew1IN9vg4FksCABU5i4l3CNaKZ3gk5qr3jYvMnmfeKr7F9SsHWCVzmZ8r",
+	"vFanE06zAaBV39ycqxDaSPc6nK9TaecjQC2R2aqZOpCgI8nBswqjZb6YbI8iXeH7YpskNkuPhmpMUPux",
+	"OzHJhTXJmM8Z3IQpojTz/cRosZdd+TadfKHlLImElJNdHhugP2XiFHVWIH7FRAwbML2bDw4OArwqZsma",
+	"UebokTx+he4xqXfHZApW0RZ1qdsHgrky46cIsgkmFKUxCUjdLad1MFFrOQH9iZDP0MjXapzKMSt3DYgN",
+	"eWMgUgsTrLMUwsDpwfMxjFdXPtgUIe3BIpJkfDpn8u7VNg2X+aSNK4IwoY2zHyIwibNMUOvFpVByDxsE",
+	"RXZ4NLJUsgMFIrfyXFpZq0TS8r7r+K2OEw6TADhTSteVgmpREMUZpDj3BgaSuJhBAqmK6V3dT+AwQqYl",
+	"aiqJmosLTYBj+MGriHGf9fq1VoJPWYAqWro2+hZjYOAb5cd8sd00aUl3GkIKETSH/CHz/UbQJSZCxFtO",
+	"vFHEza6bzXm4Ey/eTVWvv5z8n3TTFSsF9szhuqBUw+GrRei0rKLh8PUCMN6yblPENxZX8+MOGYLJxpd3",
+	"UHetxi7Q8kqV+BUvpSgWRm8QmfoLtWrvEfyZulVUnAN/5BVqDOOyHjcAj2c/JSO6fqVD7ql06o9uZddx",
+	"xaoEpaK6+G7yhSiNNWyMW6SZ4HmbK4yhqjo5rqTyBFhVHEoxYB4QpivaGEtGpgutXWgdsbElXgEccFCJ",
+	"kmaGw2i14vQTUsVxyCMOWVoTTVO1pWxtgqEweC1CByBpD82LAUgy31Yt8nRbmUKjY+22mu3wjsG648RV",
+	"N1QTH6i1Vs2MZJuqbL6OIW3HsSviMXLBZ5ip20uXrytSooPefLtZmRkd9I61m8M+3RDUROnPqXH5AcuU",
+	"CWEPWV1hd5ugl24pQ+JwTFK+m0r7KZawxVfZEVBHbbrD2iHoYreIioIg4Q7cf5oPiUeBKnk/Hd7E/UnL",
+	"R5N6uXg6s2qpaq6+oq1BXVlSt5Ng+riLx/pC6cUJmGRYEhUaxyglY1xZhwqESpOtNgWt1Wqbw9RyMD4Q",
+	"fsQ1lbuLemd9m+HZjvXoFgXXsKOJiuGszg0lQGBAWoQSxXocyYo0RtMXuBncJI0hQuDP1BWkrYTASRcz",
+	"AMnvAg1uxl0/MpW9SpgLpSRlx56cflWyuvVWl0+lhZxVbm4R3O5unJPIF2IyRUzhdSuLjVTwqeJQiYdU",
+	"S2bqTZ/EjVZaSJNxaqfxmxBkwJTCezHxuHN8biLOieNytNHZSEhyedUUDdESL3QvnR52GMZS8YaghMM2",
+	"WV0+RbQQW6YiNfQxvj1R9mtTszEKqs5Fsl9yk2aFEiRLQlZpL5UaEzdZA8U8I5egiWIvG5W+GTurBzTI",
+	"dB9PoJ4fJo88vHSaojXkX8Hx2FsE/62IkET5AGGzCu+68qg6nkxiSlokTNlg+nLcgJnrMqe/K2o0d24Z",
+	"cTP9MZFsU3Re42KqjKtSvjzMhbwW8R9FEMHztU0Ncc0+Gke2W0NG3AQRyGak07geFu9To3intG/kTGU0",
+	"Sj0Toezwye0aRpwFj01olaqadAZVdw2HIBF55TMXztSI2QlrXJo9kE3ommoN7EPRyahy7VIzhrUk2UCS",
+	"jV80FyO3hmeZkAfn3sLCrfL2D8Mh60jnyAc51mhLIUjmAG2hjJOeFNu4MdrVIMsmBFFURzbXSDXaP7W2",
+	"VM4la+Lc2BadRxnlC+rNNzPHDejx5rANQW147VFwR5FSJ1HD1l2UhimsQgYv1sf15ew4zdq4tMhaIvuG",
+	"adHmGlA/AkI3xQDSBhFzvceWrOXu86hfCrxdXVmW1NCkjRdUVjVwh6eYkD09qtLeShQ7lHhYG2zROAVV",
+	"ou3fuDb8+QfD124O/+Pig9euje5+WuXy1N9ZFbLOa0i/j95/e/jWx6O/3Bq++YeHd66O3r157/Yboy9+",
+	"d//TD+7f+pP9fXT91Y3F1ftf/nb/jT+Nfv7X/YuXHt652r7/wc3RH2/f+/yahXZaWRX199o5y/3p48eP",
+	"HZ9mHOQQtoZXFef7H14avf/2/hsfja6/muF//8v3Rzd/MXzrWn59lHLFghk0ZkHWlVHYcOhqba9FugrJ",
+	"WqLu/+3W8B+vPrxzefT+58Nbf0++7F995f7du6NX/v7wzpWHd67SSAti6XL/b797cP1NkiFKLKb7Vy4O",
+	"b9zMJkea//l2SssHr7/+4P2fFfaCk9ZuoUH97P4vP9u/9qmd9MF7//ng+rsP71zev/WX0UfvPLxz5auL",
+	"r+y/9+f9G78bXbm8f+Pj/U/+OPzkvf1P/+veF38affTOvc9vD6/98t7dAmXn2wU/7HjtYcbNfUs76NPU",
+	"RL7233jnwW/+OLr+6uju28NrHwzfurZ/+VcPLl5HZv74w4d3rg5v3Ew6BJeNYwTk3t9/MXzrjeHtd0fv",
+	"3swjhOLptBwPpU4+gfYS6P4Tzos5v6VCubKXUlyqhiEMg0/ANz3B4Ws3H/z0pgUrYnlwrFCdrAlRI733",
+	"b30xfPNXdq17n3+xf+P28J2rMXtdfX3/315H9sLhXZXn0KvDq6/t/+JjVJhfXbw0/Nlrw09+/dXFS6O7",
+	"b4++uPHVxUv3f/8Xy/p2Kvzls58Ob384/M3Nh3cuo9ajg95XFy+tri9/dfFSaisZRrq0sbj68M5la4hx",
+	"iH8c/eHS6P1fP7xzOW0reXjnyvCdq8RmkgtsbobWhuQrMdV1TaXO15Kej0DpuoL+Qq1f2RGPy/ZzPyeO",
+	"cpZhb1JmXZz3HGfnIyDG4WJdBrYRtTgrObIVbYLkoEHNKL3rA0aCGO8dtSGPr6liLymdq3YgZJjFMLkC",
+	"MnJkfubY0+320Xw4Bn/I6YH5A5QIL9dXzhaOV0dqfEmJaxunliYew/Kp5DBjcH83qbksHISNf7AuRuWK",
+	"hRkx9NHJpYrl1KpZK42ixHCttDJwFUz/tmO8JJx+ev1iPEctpcpptXFWQGxRPBfXIo4tT6m2+hpKmKPw",
+	"6otTjHtbaNSfmFnMN/UfrG82q0c+ZKDQKKOx3XEwgKT8pdQml0aJQyq1afqxYWCfKRMi8gXvmYZn3Qcm",
+	"k4qQRqVFccdezS2QVlGqqUWXFnMFtvo5q79spXfpig1tMBWjqKKgKYb56s8aNAO6szjtKM+VjjER0L7Q",
+	"GpTGe6lFJAyA+ib81fNhxgStY6cOFXSfeV7W7R+ncw7JD0GpHmp6c3MMm441OxsTfLMjYh5+9LhbbqGx",
+	"BC4s9djEpcg80wiUZcH3TMjgkbjCcrIvesylvuULZlsThPRAJlp7THTnaG3QenwirMTKKgk9TK1TKEcx",
+	"ypq7mt+osEvtwVZo3hqvyuuuhY3F1cU4Il6ld2qizSkehCYSlv3EQeN1kwbUp0XfaD7M1uFpYV9dxtNo",
+	"VEx/NH10gGqN5/xM0pTZYAgoTTfXQIFuOoLxJSmbA69DrymwuRb4KSnCg41YGYDs+mK7tvmWc+vyZVXd",
+	"m+DSSAGhnFDXhVDHwRHMU5u8f6OqKhHpNdWYaCLSByBESJU64NFL0JJylSxSrgvu2W61GMqWuzfbp3bD",
+	"DWt61uZJ4vnibBMLQKJRyOzl80iZ7xzXlyhRYvAi86a8lhG7SJWUc7OzqzJQkQmLuz9MgCpXZlUh4g9t",
+	"WcrG4iqxrS+pHW7jFUJ+VyUGibGnVEhdmCUp8bWtx7CYz1hmFsmWOhx2Qp8yTvAGJj7VwN1dW36RmYpo",
+	"ikFaGVObUs/pxykFZakqTSX0JHW3fNGbLJ9JAJ7ZS6ogm9WHdUIh9XdVvOliGrhGoITU6CTBhF71C9N6",
+	"35x1u0Jyi5aQQRPLvp91ZGl948TJ08vrzy2dapGN5TNLLz1/YnmjRWZnZ49WXgIyYwsVDXkv/4KTm8xZ",
+	"mH+y5ZxeXt9YOmtcyHRuZ+GpdtXxL4dlC2fx6Oy8ZxR7V1i24Jq6xkqxnSHG/1hntmBWcLIqRTx7JH1n",
+	"welrHaqFubke0/1oc9YVwdwJ7knYDqhmvgdzNhJZQ33juqVPLZlLNZUQEzKwya905VyPbYd3+BNP2DCw",
+	"DQAvPPFEh8+QfKAPwy+5aM7okysWdHjjZhxAfuvW8Oc399/764OL1+9/+frojVvD3/90+OavH7z+5v1P",
+	"/zG6+ynOuH/l4v6NK8NPf/vg36/eu/vl6N2bGAB96+ro+qv5ADSC5idYIIsrp08vLW4sr5x9afnsxtLa",
+	"/ztx+uGdy8MPX8GsTKC+unhpXmHk7Pat/V9+hmHHq1dGH71z/6M/7P/2rYd3ru7fuDj87E1yvB1gyKhF",
+	"Fk+cXjx3+sTGytpLq2sri0vr68XfVtY2WgS/vLS2srLRIovPrq2cW31p9cTGc+k/7JezSxvPr6z98KXl",
+	"s4unz51ayn5Y+v/mB6MsNNPIrg4W1JLF9FhOrC6bCKN5Z8BZcOZn27PtpNyDhsxZcBz7go+RwDk3jeD3",
+	"oMb0fRZ0PkKRO//E+U6kyLCATQvbv5c9O76YKjAJXBM1Mes/2W4nTB2b3jQMfeaaGeZ+omxYzKq6qcZu",
+	"YSEjNPUNiEWUEU4lnchmw2os3Fypx34szajRk8njf7lR5Ejst+MF4iYvlRydJbYZmsV1W1m5CeWEcQ92",
+	"yBaEusM51hzErypl0xrhbBElzLLm9b2k5tIDV3hx4Yx9UCIW6NlO9bjwgYGlQqd8SCUNwN5DL5R3+gzz",
+	"tbU/0u1t7mYRJYYw5yOQu07SxpbrbU3P1APTtuIsONT3c4GoJq21LTOmLkBV8TytOznmVQEt4natMWib",
+	"x77qsT7eHuur1sYcJ3SKJ8VPJczwbhiDl+h2FYxBrD2tprh6nOCbniFlb9dxRyikPrk75gjzLcuFdwnS",
+	"37z4L+bZuhPT6d/kBNcRK+NdT0BsJf5ehxtOl0OLmn+ZH5ssv8L93dL7LKYmBdP4QsbJft1nisSV+2PY",
+	"H8ec6OoSls1q/xvjFNd2NETnpIE+OD4vfo3afMyrJzVq/XSsafO6ea/lPPU4kSk8y1SDw0nqERkneYoX",
+	"isGuhFooVF33A/QYT25YY2AXDTBbS4AFfoXkT1GDlxJPjjVIQemTwtt9fFdtfXprr2gAaxnB3jfCIpOO",
+	"JoNKpUNFrgtKmUrjb5dVcO3vf3Nr52hBfbQx0oxTiWvNAVe5rWwDzV3IJ8325rz4Ga1auwjdZwYDyJ4/",
+	"KfoWhr/5JOZ+FnTpya4pBspG0UriNNOE8XOKsSIsNywXeDivF7+u1Oc3pEsN0SYzhjlDw5hPfSuMiUZr",
+	"V0Tcq7HMoYzmFH7E4IvSYzlyVfi+LTsXpl/fTSZOHuop5mfzy9tydGZqQ9Us2eiDMXx3Ozx98So2CZgm",
+	"gDnqiGtmVzM2PJTUkvoBEb5XsqYTV6DDTTfCNNGrM+mfBX3aUOF/reQ0MPXj45xm5fN6w/Hp9sHKCr5O",
+	"Ua48Z1FnEJnzzjNyy/CW0jYR9e1fed+gZjkr9BgZrtEvCOhXqDdVzaB/iojW23XrWoR5sy659DACoOgA",
+	"7FKFIv6yTSfCgkl3UMmdWMzzT3wbNjYbbR6rajb+z7ohDaMVLajpnCuBBmMvyHVTnTqDrcPEvtVA7Ijk",
+	"ZqzWK80ioNzNtzKwuP+ZKrwMmerwWBrQeVaEkh9bkfgxMa88kO2+ULFUMPxcejkgfnQfYZiHEAzRil/i",
+	"6fBY6x8xtyriRjWxrVpx0j7DFau5ezRMGmmJwkd5XJ8hEgFTKnm7Rx3Ffg1OfgzcS5BMNpU2O+dkDu/7",
+	"VtyQ5YrA/OYzDjEC88fTwjXTMNDhph3XzaVmRAh8lhj7ZLp9k5bvlZ8X7PA4DpBMzXt1JsK6OdJ/Ysta",
+	"w46eM8c6kwlEVvfJvAXS7nADsBCr7w5H/lwgFzrZGwwdZ6HjPNl+8vhMe36mPb/Rbi+Y//1Lx2l1is8z",
+	"dJyFC7Ozs3t7naLNUca9qgYGViMZLL9lHTTOdTQKIm9G+2wAVhXZfOtYfbPYB3cLSx0L2dh8oV/2bmGR",
+	"h22xiRn/deYYSg8tTkgy5HAt0sdOQVyDqiFKVqrZKAdjwYkYRyWjd3hdLWlteiYJyH99eZlisewEmsWE",
+	"GJ+RSQEQwtxNdbrptHBN0fQAfBEGSbEryEJKdGFuzkc4bHZc+F77e20HFcXLlGWJsmOz7dljzt5/DwCt",
+	"EeMIAWoAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -38,8 +38,25 @@ const (
 	Desc ListExperimentsParamsSortOrder = "desc"
 )
 
+// CPUBreakdown Mean steady-state share of CPU time spent in each state, in percent. Only present when
+// the group was started with cpuBreakdown and the collectors report it.
+type CPUBreakdown struct {
+	Idle    float32 `json:"idle"`
+	Iowait  float32 `json:"iowait"`
+	Irq     float32 `json:"irq"`
+	Nice    float32 `json:"nice"`
+	Softirq float32 `json:"softirq"`
+	Steal   float32 `json:"steal"`
+	System  float32 `json:"system"`
+	User    float32 `json:"user"`
+}
+
 // CPUStats CPU performance statistics per host with confidence intervals
 type CPUStats struct {
+	// Breakdown Mean steady-state share of CPU time spent in each state, in percent. Only present when
+	// the group was started with cpuBreakdown and the collectors report it.
+	Breakdown *CPUBreakdown `json:"breakdown,omitempty"`
+
 	// ConfidenceLevel Confidence level (e.g., 0.95 for 95%)
 	ConfidenceLevel float32 `json:"confidenceLevel,omitempty"`
 
@@ -61,8 +78,17 @@ type CPUStats struct {
 	// CpuStdDev Standard deviation of CPU usage
 	CpuStdDev float32 `json:"cpuStdDev,omitempty"`
 
+	// HottestCoreMean Mean steady-state usage of the hottest CPU core (0 when the collector has no per-core data)
+	HottestCoreMean float32 `json:"hottestCoreMean,omitempty"`
+
+	// ProcessCpuMean Mean steady-state host CPU usage of the calculator process; cpuMean minus this is background noise
+	ProcessCpuMean float32 `json:"processCpuMean,omitempty"`
+
 	// SampleSize Number of experiments used in calculation
 	SampleSize int `json:"sampleSize,omitempty"`
+
+	// ThrottledPeriodsMean Mean steady-state share (percent) of the calculator cgroup's CPU quota periods that were throttled
+	ThrottledPeriodsMean float32 `json:"throttledPeriodsMean,omitempty"`
 }
 
 // ClientHost defines model for ClientHost.
@@ -168,6 +194,9 @@ type ExperimentGroup struct {
 
 // ExperimentGroupConfig defines model for ExperimentGroupConfig.
 type ExperimentGroupConfig struct {
+	// CpuBreakdown Whether the CPU time split is aggregated into the statistics
+	CpuBreakdown bool `json:"cpuBreakdown,omitempty"`
+
 	// DelayBetween Delay between experiments in seconds
 	DelayBetween int `json:"delayBetween,omitempty"`
 
@@ -278,6 +307,9 @@ type HostsStatusResponse struct {
 
 // LatencyStats Global latency statistics from client-side measurements
 type LatencyStats struct {
+	// CacheHitRatio Cache hit ratio reported by the target (0 when its cache emulation is off)
+	CacheHitRatio float32 `json:"cacheHitRatio,omitempty"`
+
 	// ErrorRate Error rate percentage
 	ErrorRate float32 `json:"errorRate,omitempty"`
 
@@ -356,8 +388,11 @@ type ServiceConfig struct {
 	TargetHosts []TargetHost `json:"targetHosts,omitempty"`
 }
 
-// StartExperimentGroupRequest defines model for StartExperimentGroupRequest.
+// StartExperimentGroupRequest qpsMin, qpsMax and qpsStep are required unless useProfile is set
 type StartExperimentGroupRequest struct {
+	// CpuBreakdown Also aggregate the user/system/iowait/irq/softirq/steal split of CPU time per host
+	CpuBreakdown bool `json:"cpuBreakdown,omitempty"`
+
 	// DelayBetween Delay between experiments in seconds
 	DelayBetween int `json:"delayBetween,omitempty"`
 
@@ -368,19 +403,25 @@ type StartExperimentGroupRequest struct {
 	GroupId string `json:"groupId"`
 
 	// QpsMax Maximum QPS value (e.g., 500)
-	QpsMax int `json:"qpsMax"`
+	QpsMax int `json:"qpsMax,omitempty"`
 
 	// QpsMin Minimum QPS value (e.g., 100)
-	QpsMin int `json:"qpsMin"`
+	QpsMin int `json:"qpsMin,omitempty"`
 
 	// QpsStep Step size for QPS values (e.g., 100)
-	QpsStep int `json:"qpsStep"`
+	QpsStep int `json:"qpsStep,omitempty"`
 
 	// RepeatCount Number of times to repeat each QPS value
 	RepeatCount int `json:"repeatCount"`
 
+	// TargetWorkers Worker count the targets were started with (cpusim-server -workers). With useProfile the capacity is the profiled throughput at that concurrency; 0 assumes unbounded workers and uses the peak throughput, which overshoots the capacity of worker-limited targets
+	TargetWorkers int `json:"targetWorkers,omitempty"`
+
 	// Timeout Timeout for each experiment in seconds
 	Timeout int `json:"timeout"`
+
+	// UseProfile Derive qpsMin, qpsMax and qpsStep from the dashboard's capacity profile (CAPACITY_PROFILE) to cover 10%-90% utilization; the qps fields are ignored
+	UseProfile bool `json:"useProfile,omitempty"`
 }
 
 // StartExperimentRequest defines model for StartExperimentRequest.
@@ -2361,70 +2402,130 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w8XW8cN5J/hehLAOkwksZxHEB6OUS24wiRnbHGQh4MXcDprplh3E22SbbssSHAuYfE",
-	"l8uHkfguCC4fOCAHZ3ex6wQbZLEb7/6aHTl+8l9YkOzvZvf06MsJsk+SutlVxWJ9V1G3HZcFIaNApXDW",
-	"bjvCHUOA9a9ne9t9ic1jD4TLSSgJo86aeoNC4EPGA0xdQEJiSYQkrlCP0ZgJiW4QOUYuo0PigVpDqAS+",
-	"i33hdJyQsxC4JKBhZ4s2YRd8C7oMiq9WoAVYHi13UHd59QwaMo5Wzzy/6HQcOQnBWXNoFAyAO3sdxw0j",
-	"9e0muwG8ClY/RgMWUQ+xoQJio7cB7nYY2uDqxweFexHfrEK8iG+SIAqQ4vsu9iNAbCCA74JXBwUwtYAB",
-	"TDWMSOARIOxyJgTCvo/gZgicBEoI0IKQgL3JkjpUqGPrRWKDT+h8ZPaldw52q4D6ElMPcw95sEuweqgY",
-	"mVJugyZwEPrQJ7egCu6SXqVA5PcZCfAQocjFvhv5GksGWJ3SSEHeSx+xwVvgSoXrrE+AyleZkApXUZjh",
-	"pgROsb/RU3/FnwrJCR2pT/Xp17+mOADrCw7XIxASeB/4LnFhe2vTsq6ZWKXMkaiS7EacA5XnU95seBYd",
-	"NItyHEQb5xAZIh5RqpB3qkQD58yiH+fVYxSA0FJIhmiIiQ8ekgxdj4BPbKASxhQhqV0h/cryiUi3WxIu",
-	"w0Fk3qOFHlCP0FEHbZmddBDjSNO4WAVr5TDzfXAl41sgIt8iEx6WWP18jsPQWXP+ZSUzuSuxvV1xEyBv",
-	"ZudwTn2X52Nlj8rSXqqTmToGKOw+SPA6Mec7iDL5ppCYy7yqNu1Z82cLRMioAIsW1FIcH7v1nSQBCImD",
-	"UL3VzkU6a4p7sKRetaSsyD6LMzG7Rx4W4wFTViYn09wcYdVFFY5YP8OeRxRM7PcKa5tOuSwqe50SdTF8",
-	"NOQsQCnWvN3qoGswAQ8NJsbPFsU/44N2OaNZBMXKcNYsVuRE3JjCCuPOxW+UzRTgMuoJmyEG6l0hRiLb",
-	"HGEs3ZpzREIwk4XZ+WoZdDIZwJzjScFYZgrZBHGrtNyoDpfzbaNO2zJ6Y4szrxifT3SpKJKNqt+kZeEY",
-	"C7BZhcQA6c13UMrF5IGQLOwgkO6ybf9HrLwXOItCi69qJdUlMJl0x67ucq9f7+Eu9/px9DIAQkdIKiZ4",
-	"ltAgBbcV0XpwPKI6RnVz4BdOLQ2wAG/RCrUApwz29dDYHJR/bNOqTA2LAN4YA0VyDGikWINSZ+B02uor",
-	"3SWcUcXdswezMRqzLcjYpuR6BHl7bIhUobMkQwLcRtD1UPQYobZEJTGnjI8wJbeM2UwP2Om0MzmXe32N",
-	"wGZrCpaikdOZfz2cQeFJmFLx4wfSsewMS0EL+HiyDvIGgM0ZqLdoYF4Xwmqbd8gJ9/VQNKY4mfbFSd6Z",
-	"bnexFlJTFlKBdKoBUl9CaMtDIESC3AKtwSlAMRMihxCwPMsiKpvSEW0zVdxr1iPA7rggnlXI6hMWWaBe",
-	"MS80pRpOTosaz6SFjJwDiYlvS3bSWEuvsEjrK5HvIxUAa8LKuSbJKUhbbazGx2WdHCW+Yw4f0YoPm0TI",
-	"+qBXo7WwQH1VTD5RvHTuHcekVrc8t/ftOJJJbCm2XFGPEU1lNCX1AHIzg1fGBcwVvNQZxQRTbYx15AHK",
-	"Bh0yW4lFYi3teKCUEaugiUM+xajmFhywBO9l2eBBcqJzAwsUf9LalQyJKY28tm6xccq8KVtURGNUlvig",
-	"dPS19TwqQuVLL1qNE/Ea49+NczbiAuYp1z4XA3wsJEo+bMmG5uNsVu2czarX78pRH0DBtVRZ9DuMFcIe",
-	"Y6q3scpaz0W9n1Ua04Tq8m1YKLGVfM/xGJkizyyY1foeHoGYDSvUy+a1V23O/hdor14F7Mtx/eYy+g6P",
-	"v+NEobRGwknJzbyfPxx5lQkpTPmyfiuupdDZWIspr1eYMR+BzKGz1qZlJLTF1It1FUaghTR/Fott1f5K",
-	"ii1HwWEdu41/m1gCdSc13ZwLPhtgH/lmUb6ZY6pQmk9LgniAAsAi4pAoqqXot4Ul1JV8OZagLIwLVNaU",
-	"8WMiGlOEhFBCUUB8nzQUoxJw9e2QAwBrSjrmB9c707WR5pEDEddbtUBb7cpxwnfl0Q8C9owF7JnDg121",
-	"gF09MNjjagJ1HDnmLBqNQ1vm1Y9cF4QYRn5SMzN+1BBqozOSxCe3auqsylgCR7k1aMHHwcDDK0Fk6cdZ",
-	"FZ5hbx37mLrAT6xHJeZtTaV1ldq6jc4ZkQjBJUPiFlLjAwRohd6VAS7HRCiweZNd9YQlm+yXzGmTjS+Y",
-	"XlNrqBKYlSpSmkLNGJsoZvb5wF2IpLFfaT+c7W1bm/klVlVO8rhqVVvVSn7bRpNoczpZjTvGlAWEKYeO",
-	"s41WLI1Wq2xpiyrWLTNIMEo6MQuM+pP03fbWpo496sKi9gGRFvKSCWkU8vzaYhhVr42F8EmypNuFApA8",
-	"CT7mj6Sq+mrluzqkSrFCS0CbSugQa2Hsdg5WFQ1MIOOsnX6p2+04gYkdNLwj6AZYkvmkvlZRksNV4gN8",
-	"cxPoSI6dtZdO630kf55SOadUbsRZc/79Kl661V1a3VmIf1na+dfk0eK/PVdT0j9QlTjl7KlugbOnjrKA",
-	"PC+SQ9WW50J2VGXnPNJZOA9fkK5Th1PW5FCZbMLBc9aupuKbnmUqORnji1zJ6N2ZbRZqLQI0jspUdefk",
-	"tMYWSlVC0tidQ7EqCTd19KwOfU6xqxWBXO0vXnNUJ184gYwCw4Oao22sHtR5ecvcU4ApHilmFgeIEOPJ",
-	"CNHisdVzco7O0huPKxCNM2Jm8K55xbEkCM3b+edw2vEMp9XOldWJD2F0IxlRtQxPJWvSOdb6vLw+iKmw",
-	"44BTRzkM848dlSTq+MxyHNC2nnDKjuyi/lIdV7v5g5ZWpcGQZuAyuncaxapMY9WyToSE4GLGhHZ77xc+",
-	"O6D9zO80+75Toql5g5dA3mD82sbr1a0NJhLEFrhAdsFiltbVa8Tj98WaUKWb1pwFaEx9sMV1BovQfaBD",
-	"YAixew1kw256ZsHR7CfGZt9RgumQeyqdf/Gw8iytbr5IYLN89MvyXTKrcWUxdcumJTOxNjvlGDjKvkhT",
-	"e6LYrkIXr+DNBoz5gGns1LeVy+qZwqn9zoaZ+y9U4FOODn2GZX3s37WUMQMIGJ9otFoIbXVstSLGSyjS",
-	"PJ9fWnKIavdXQHVkW6SJ8qcbbGe9MqNRFsPyQVnYaN1whZZOvWjZBHZmqasiuXgXOB5BEjPbh9ymf/7j",
-	"9It3p598MP3L/f1Pf3jy6fdPH93df/i7xw8+fvroP23cr/C43vnvv//24x9/n4JNYTa6/yKIxz9+sv/F",
-	"VwZE3aAAjXwfD1TqI3kEdbGivbP15P5nPz18+PjDdxV9n/1teved/Yf32+68OY+c/uHLJ7993z40YaLS",
-	"JLezfPv1dz99//8/PfzT/nf/sf/f31p55mMht0NPT5JUWf/5nem9D/f/9/v9//m2mXvVeAffbJYZBfvr",
-	"B4eSmYDQ2Ti+/ehQOJJmTg943zigKpaHHz1+8HHK5qeP7l7u9dvDzzZg7QKe6T4/vfvO3//6wSG3kUNj",
-	"bw8ePRpru/Do0Vjbh0eBpmGsd/rozvTBf82pE3X5ntHxx+/9sH/nbUeZMeWIrjpZ3iokC0MdkeQntU3y",
-	"umNDlDYj643D/t170/e+ajYOTQ3P/PfTe19O79178u5HTx/dNU9W5mCznutpIPTOj81UNjZS9z//YPre",
-	"/00/+2Z69zeP739jbHTaSW1HYjWl3tMFENvsX7Vbo+f+GeMeoVgSOkIeUSc1iCR4+jqjIEHcdS6PQBGp",
-	"/JGO2vrZogzFy70Np+PsAhcG+QvL3eWuYgkLgeKQOGvO6eXu8mkdysqxZu5KdntiBPpclbvXkJX/cS6A",
-	"LDakMnXT37/Q7cZ3MGQcguEw9ImrIay8JcwhmGBozksBe5VWZN/W8dIHIqIgwHxiCLZ3xvS6lYylS9ls",
-	"brzzcpFURpwKhJEfd6eKk8rxFKy51BzEM55Op8S/TSLKTSVxnDxsmk62cLR+DrnIVb3Oun+ducW1x1J2",
-	"oSdSBcLUMxcd1K/V9pHhX+RLEvoQtx8qI5NFptpadU7qnNeZNzk6mWzoCu4V43gVKO6d3NE2Hev5MpPj",
-	"DjTKXIGvS0UvHiV9hfufFqrWsZdMwxjcqyeHu59lzYNITErirU8ZYUThRrVHarcbK7fjTtPeTAtSEfkg",
-	"nQinntYqLARzSUnukdWgXICyOL5B5Di5a6EsO8cBSODCWbt62yGKEGXtnaQmneuQFWW3k+P1IYqbezsn",
-	"pwPxJZQ2GqDNjBezSUvfiycnfRVqKJNoyCLqWbyX3UKWjG+6kxnCucJBRCZktVvpLf0e4aQvghhP+hsV",
-	"QvTs540xcEBEIh+GyncMKyJqQFbt869KNOcSB3NIPx/zjBYMXdjngL1JNqqlpCOV3cWfmRVPRJkiQhOK",
-	"Z5rzNgFgiEcqWgevJhScLwi0WOlyrTu9ulG4FqwVJuk4xhoTX8zI+JtOIp2a1Tyf495HHWY9VmvH3p1n",
-	"ZKRKzCsEfN1hFYxLNJjUEKHerk/sJORuUWVZdP5Z7paRvqe0Y2kXVZqxihzGPT22UUfR6/F7G1EKXI4e",
-	"rP/SD3eelb2aP0moTQ8s951q84O+yQmySxKIUQ3ETAGKDpJjoEnqkNaqZ2UDJ5MIPPMcoKV/+dUH/jle",
-	"JN4sqaTV5wC2/wNTcRwrt/O1+r2mEkppzqJNKFRqwv+S4yFzJ7vxaLz42vazispbxuOGzGY5WBGShfl4",
-	"u2ytWFgwVr8qUWhttXSF22K1nrl4nLD5usQK/4qqxnKxUM9uJilc7gtMPeT6gGkUIhENlso+fGWs+8O1",
-	"xsu0j8+Owb12nGXL0p3U5tB/HLe0i1wwIJCrSTVbY0KhSNss1ij/sgrfktm6OLKPryEY3hHFRg3KVovJ",
-	"3ws9Tv5YbrvamFTYhiHaUh6vWVVlVbUXcOw7nb3JZLKzada20YoXh3UNCv0/Ka3ZWLGFo+PPiPvOmjOW",
-	"MlxbWfGZi33FxLXV7mrX2dvZ+0cAAAD//8i9IFxAVQAA",
+	"H4sIAAAAAAAC/+x9+28lxZXwv1LqLwj7+9r2HWCQxvnh04xnAIsZxtgeoRUzi+p2n3tvxd1VPVXV1zbI",
+	"Elllw2OBkIQseZBkIwWRJQpDtFHChsnuPzO24Sf+hVU9+l3dt68fQ6LsLzC+XX3OqVOnzruqX/ECFieM",
+	"ApXCW33FE8EEYqz/ubZx6woHvBOyXar+DkEEnCSSMOqtejcAUyQk4HB/SUgsAYkJ5oDYCK1t3EKSxIBE",
+	"AlQiQhHgYIL0KF/9mQAPgMpldJNG+yjhINS43QnQ21ROAI05SxO0i4V6h0sI0S6RExQkaU4QwjREamzA",
+	"oggCybhAHBLGJSJy+Tb1fC/hLAEuCejZkDAC9X+5n4C36tE0HgL3DnyPsF1MpPsRv+v8nZLADUuwkWx7",
+	"SfEqcj/ZFxJi56NUAHc8OPA9DndTwiH0Vl80oyxZObh8ZmYeBXEZKb7hyR0/g86G34JAjd9bGrMl9eOS",
+	"2CHJEtNrjqOlhBEqFUEjHAk48JWIbEksRVM8lAwkwEeMx5gGoBefCEkCoX5GEyakXVRGRyQENUYDn+JI",
+	"NBZvWJbDb3AYeave/1kpJHfFiu1KRWYPfK+Afh2mEDnoLNBHagRagOXxso8Gy5cuohHj6NLFRxY9v7ky",
+	"QZKqd6+zXeBNsPpnNGQpDdWOuHTxEddEO+DeShIXXP3zSeHewHuOfYz3SJzGetNOcZQCYkMBfAphGxTA",
+	"bepAwUgFHgPCAWdCIBxFCPYS4CRWy4QWygqjja03iAs+ofORuSXDqzBtAtqSmIaYhyiEKcHqx0xlacpd",
+	"0CZMShByjXHomHxFFxousJFWUfZ9jSRgHNDCQCu7qv5CEywQZWp7LOlRIZbYyaOEswCEWEvSvuRMmEVf",
+	"oSvAUZBGWOG2IL+J7AKjmNBUIDkhAhGBhjjYUUqZhogyIpxcEjhOItgiL0OTouf0KIW3LA2pgFCZg4wO",
+	"NTYHrGR5bCDLCWdSRhBuACcsFH1nbQzSgrU2i45pB9rSPCo0b+6mTGKUGBxITrBEu8AB5eibsz6o606l",
+	"EyMCVD7DhLYpVT0GexI4xdH6RkmpC8kJHatXCe18THEMzgfKFoCQwLeAT0kAtzavO8Z1E6v0eCqaJAcp",
+	"50DltXzd1kOHFjWDSquL1q8iMkI8pVQh95tEA+fMoeGuqZ9RDEJLKhmhESYRhEgydDcFvu/57YypQlKz",
+	"QvqR4xWRT7emHgwHkXmOFjaAhoSOfbRpZuIjxpGmcdHze3E429+bINLIIRNqm88ya7mSeKlYh6vqvTIf",
+	"G3NUu/65NplpY4DCHoGE0Lec9xFl8iXriPWbs+bPJoiEUQHNGbdTbJfd+UySGITEcaKear9CequKe7Ck",
+	"HvWkrMo+hztgZo9CLCZDpuxESaa5WcK6dxJUl1j/hsOQGK9pozK203mpwlH0Vqmz8NGIs7hkOEo61Uc7",
+	"sA8hGu6jSVP8Cz5op2E8iyC7GdbMYEVOyo2abjDuqn2i9LmAgNFQuIwE0HCbxNB3Ca10GwdeQjyThcX6",
+	"ahn0ChnAnOP9irIsNmQXxM3acLN1uJxvGm27raDXapx5xfhatpeqItm59bt2WTLBAlxaIVNAevI+yrmY",
+	"/SAkS3wEMlh2zf+MN+/Tymw7bFUvqa6BKaTbmrrnN7baLdzzG1vW/xwCoWMkQVTUYsltseA2U9oOjqdU",
+	"RxlBCfzChaUhFhAuOqFW4NTB3rSRGir/7NpVxTasAnghc0tNCJ4bA8/vu1/plHBGFXfXTqZjNGaXk3GL",
+	"krsplPWxIVIFP5KMCHAXQXcTsaHCVsfmy9Qp42NMyctGbeYL7Pn9VM7zG1sagUvXVDRFJ6cL+3o6hcIz",
+	"N6Vhx0+0x4o1rO20UirGOTc5Aa6nV0oGRUSqYAKPxxzGWGrnXzI9qkgPFGQOGYsAUyP0Ed6/AnIXwGV7",
+	"1FM0NI8rEYbLGJX20t1EdMbExWa3WYGLg8FiK6SusLUB6UIHpC0JiStwhQQJ8jJohZEDFDMhckgAyzWW",
+	"UtkVmWkVrdxsM97k7Mq7oQlZvcJSB9Rt80BTquGUNm3nmvQQyasgMYlcsVXu2ukRjs3xVBpFOqzWhNWT",
+	"E6S0H/tu/qY7XlcB48xUzWGSevHhOhGy3cfWaB0sUG9V43Bkh849Y0tqc8pzG3vfk0xiR3ZuW/2MaC6j",
+	"OaknkJsZvDIWZy5fqU0HZ5haXboz94fW6Yi5MiISa2nHQ7UZsfLROJQjmmYow0Ep5suyw2AVr+sUvX2l",
+	"t+UaEZMlevaKQ8cp9cZGdTRmy5II1B599koZFaHyySecyomEne72+lUXcTELyYjMx4AIC4myFz3/DJaz",
+	"e2sX6Dv2d2OpT7DBtVQ59ndiN4TbpVVP7ZZ1rot6PitLqAnVhYKkkpOt2Z7zUTJVnjkwq/EbeAxiNqxE",
+	"D5tXX/VZ+79BffUM4EhO2idX0Hd6/L6XJtLpeGcZPvN8fndEpRWFyZa2TyVw5FU7Uz/18Qoz5mOQJXTO",
+	"YobKUiqNqQfrpI9AC0VRdLHvtt/OsZUoOK1hd/HvOpZAg/2WuuHTERviCEVmULlsaJJemk9LgoSAYsAi",
+	"5ZBt1NoC4GACzxC5iSVxmMY19RhNiEQ6bWVLxyYCVCresjMr1BApkIaIILaVChXJsNHIWaDRSatNLKEt",
+	"vc2xhKwK3lJ0shzojE8yLhFVr4ki0pF4y8C1l05OAKwr4pkf3MbFgYu0kJyIuI1LDmiXBnKS8V25EycB",
+	"e9EB9uLpwV5ygL10YrDnW4xLx5PEFfZtpUEAQozSKMsPGiNuCHXRmUoSkZdbcspKUwNHpTFoIcLxMMQr",
+	"cbrYrxp3neHwCo4wDYA/tHqcmLcMl+eQWnNUOmBFIoGAjEhQictP4B1W6nQGuC71qtRnyV40zXDNIEQ1",
+	"Xd5lYCp63yQ6mgTm8ypo0k0nTlEsjMOJKy5Z/0qj1KLSVq6elRqrGit5Xnm5zWbVom9RTfRZnSKfbzEV",
+	"3mjOofMsGVbTwM0UX16Os3vLtL2Ms6rTAlOdZNmzW5vXtePT5pP198a0kNdUSKeQl8dWfbj23Vjx3STL",
+	"KnsoBskzz2d+N665X518V4vUyJRoCWgSbLKcPjJ5U92CZ9OVCHNAWUsaSmkEQpuUDc5M3C6QAEe6oZFG",
+	"HmEt3brBrL4nL0eCFdlj7aelAviKaXhbMe1uK4TfXbGtbiu60c1mnsuNidl27plstkQN/JMlnmPjrnmr",
+	"jz85GPhebDwkDe8M6juOfEmWwmyogtPVVmK8dx3oWE681Scf1/PI/rygwnopgStY//giXnp5sHTpzoL9",
+	"x9Kd/5v9tPj/v9FSpDlRIj7n7IVBhbMXzjJHPy+SU6Xv50J2Vpn9MtJZOI2yeoHxHeCie3uYQShQ9JWi",
+	"KmHaqiqtvQtBkgoSLwnj8i3tGviLy+gF9bikSUwHV4IDIveVWlF/J+ZZiArnFGFpWrgCRk1xNdj/Jhog",
+	"LESquJFS3USp8BtUWpulAixEwDslaD7anZBggtgUuJgwJkWVDjayYJYiEhM1KztVb9Z2P30JpU27OFev",
+	"YORsdXsVOJkC6lD6OiSXk1K3zKOiYIpdFbSwdnnj8tr69j+8tLF586n169cWjZFTC31h8MjSpcEjZR//",
+	"mxri3USgEYEoFNq0kDFlHEKHvq61Qmcarro5Cj7fmW0DS+avO/k2S4U+POXpihsa8Zf1XaGa/4c9HSqq",
+	"vT+n9mkV3YKVyI45scTWVreyAgUFhgctS9uZp2tzaR0NjTGmeKyYWe0MRIxnvYGL55Y5LXl1jqYXm+vr",
+	"bP40PdHdI84lGu6ezv92nZ5P12nRr9V9imcrO7OjiuFd53aQIDQAa21hSlgqTFlOh+eVUz236fYEcuUd",
+	"hihNFB8vDAbL6PJQH/VhppA2IlzIEpiuczv51hlFDEtXLqk4yFOd43oYgZmXdjVYKoXEZu+GROyg9ZWb",
+	"nt8HvjnYM3tgdjxo9sjSeaG67R0B56ZDBjhPE6kdDB/FTMhoH+0ApxAhClL9bGS9B7rsCFLT1UAS7wDN",
+	"0t+T/URJpGBcGw2mu3nG2qD0Q5SfaJo9NjviNGvkX8uZp9LW0s7GDROlN9lqDheg6WN5IN88gGAzFj7C",
+	"w/wMnDoEYt8lIs9zQLiM1qkufODbNGBUYkJNj5VQRx3YbtFuNYEMMMKBTHEU7aMxk9p327W9WUQKe+Ih",
+	"P9/QcnIuSNLrJCb6/EvLSS8DyFRoknQ5xns6laxe8JGtoaTU+sa9JChI0ltKF28YpeJGa06zdGqmgiil",
+	"xnSYhWLAVCBGQVOIRqnizzAV+y7KHO57QWUMMeP71g5d2ZeGPz2aE8yL16ZZmrZf8/+N8ls5lBt4L0dd",
+	"5ZF5rpajexXa6aTcnrlxpGr1mgMdMR7oIlx+dEYwNMK8L4LtTPyaKCxuJUsm/tKRgcbLMVWafC5cCZaT",
+	"JhK71dRDxEFVPqaAbFuifRZnQUTDuaudTGqV1tzOmuDS8qlTcN0HkJry6Y7dXdKaw7klIGjrXdCGsqQS",
+	"VK0p4Kzw3mcyuqaoNdebG9qv6ZWyrFXFok54O9udG7KxTc5G+V8lYmf9psPbWLmp1llpl2HEgh19zjAA",
+	"o4dWEs6CFeV16KS8j7DQteBKmNYtFgsvA2dVB4pRaGa7lT7rJ452xSGjdIJD5ROppR9FZDyRpxS7zE/O",
+	"I02PTmMY0AuuHcUBh3qZNoBvGSktgiaWDiOYpZMVhHWWiPbDPSFSY2qlyfkU/y4nEk5HpwYxi1A96BSU",
+	"1vaijUvqTHbMp8THMql+Ra7udEYezbNWzsCVMLqenVt2cCIbkx9ubi9/t2fRG2J2woNMJQzzn2SqxbLn",
+	"lxCKC4+0V82o7GCoN9Vy9TvS0DOf0ZHCKcAVdHeLlRaWEQ7ApX2fMwERKmlhko0/O2W73NS2autsQgBk",
+	"CmGFM5mV7E4D6/e3rK6e892Qs0Ss00IR1TOBhiqU4GAHpEBqfAJhiQueP6/y0jhvprIN6TbHVMREyjPG",
+	"a44DzpwsMuPOAFWPOZ4FstZWEsu8U4iWhXAi4XKbj6qwl4W3SW8Vf2MBm2yuy3ND1rq1gwmRijMi9W4y",
+	"9VQFxWSqShQlp2waK5dsGW1qDYE5nIOWCAmX+y2xmmootj2GKoOhzxYyNATtAkiVmsHBjufPt37KT/sW",
+	"40+pKo9ok2ONWReC7K0HygHU1UIcGg4ph3X+2JjQJub5QCR4DLpTsz207p692MXJOu2auEBqTGIinVM4",
+	"hApKh66oolKB62mdTyUOJ2NLIz6rMNkvS2kDV42l9Xm75M0lCWcTgt2oJVHq1QHTqjsFZPMgoEeaejRw",
+	"UU26yUk2TqXcJFdYuErAUSYRUDw0oWjtmDUZT5xGKMIk1n11gekpthTo8b1yFRHb7ZtKwns9RzIW9x/5",
+	"LImiXqNr4qQI9z07UUWbwVvAPKu1r3qqzeiinpzt5wFXc7rFZTtzA9qovpcnxecGtFV5TXnkwfzT2l7b",
+	"KEM4QU2yvMbF+/VZdRtn652v32wuV8OBrm4prX0Qz11Zp/I8gavtwiJsLvPEGBw+W90a6AFnM5+af+fG",
+	"dMo51db/5A5gt3xscBAi5Y6qavZEFSMjlQIw5Ks4veTDJXZUU7PbelnIwCj0hLMpCQGRZk+iKgfMs80N",
+	"yi1FlynpxXDi12t81rDORl9WEXUkBO2ZKFvMNQ6hxGKn6NqKdIcvngLHY+XMmD4e4aMnB6aL6vHBQCzf",
+	"prc1/bc9W2/BEkWAjW+sIWYXPBp4tzXjb3sGo1pkyugS0bXbHL2rPIWn4wuDfqVGPB0/Pug/9smeQ/WB",
+	"wFutpwGNyGaMPWU23cw2Iy+fUkHEWYlL3erVZua8Pm4BxCKKsQwmxutR8aKPRBrHmZwoTugBKr6xb4Go",
+	"RV23aZ+wK28myGqmFpzGWqucuquaPWqLWcUmo/SsC4iqCXmtg5KCSiQSHZDlbLTVVaVeMFfuaX5Jaq3O",
+	"cprcPaFTFqVUYr6/Jve2dola29ZIco1RCXsSCTvO6OaEA8R6iK+OsJBxfuOicrSB6pf71IRZAvQpVyny",
+	"ZgLUnA/PfmfKvSc0iFLd5yGYNkG1/fb4Y7ONK3Hh21CHZqxoNKXZR2q6+6pkGUGlGE8E0m04pV7+Bj1N",
+	"GpoXWDVKJG5nKQvfhWRKVevTjERObPQZEyGU/cwi/ZKc6/RpFiYt9lobDtlxjwY5l5HkygUIc/Cwp3tk",
+	"la3AiMIu2li/iiRjO7orIYlwMKOq7+zbtyS4DzjrJyWgRfEBNc6plHjPhWjJ12yC0P2dSIA0fd1Etc+Y",
+	"IHlOF05OdEHKKQ/db55+d+oipe5jpGrZfaT+FohxJCKApF+/j6sc5pLJLJclWSaUPcDX8xVEG86S/m6o",
+	"0dK6Fcwt9Ecn22bovN7Fs0Kqcrk8G9O8mdLnU0jhBWezm+3lUg6T6eJTvZDaOgxTmWcWzWlCfcatu62v",
+	"5Fwr5zXUOdLbtLuNT29sRq3TLXKlk0MQLoOcAFfEi4gEcMOx4S4bh1PPAQ1hpCyv7k+U2VuOymAP0TUs",
+	"2VYsa0da3OhgndE6I+eX4wpivz79s5GVLcXxNALe6sjlI1ARPO2ofa8OAO2qKeKRBF53K5DAMjXFNCyV",
+	"T+T0r0rh3HzhkGnnPN376tjehX4OvB56sf/YnkNN0u90s1BbT1xROtllWrXwGBUOodXg7tYnBcY2Ezts",
+	"WaYjtHArL82cg8JDNoW8rdCc4zG+r9kFd5UequyBJmZeU1f9WFBRcs0Uo1rZbCny5avNssa7M9pQ9Rxe",
+	"TejzkMh2dJsrT/bbr8hr9oIqL5HrAwthpYe95OnUj032TG3W78bv32JZuariNPGEbn1qbZqCKWTVuVr3",
+	"VB5MJphLfczbRIsREdqTjBgd695YOQHCs4LVnN0PtqXL4XLnPQRiZsuBmYMABTPr31Zv+lmz9vpN4wuZ",
+	"s2uEq/h4flrLXRAOgmO8tzZreW/VltZxXb2POEwBR9pzHkdgLqa3ul/5zhMShkXbuM0JnVJG4loJd57e",
+	"WPtWDkXPscWDN29YCT+5815C1MrqCqoz20xVgerPqiINf6D9jhNJipHziI1JgCMjK8R8AYLxEDhaMEFw",
+	"i4u46IyB2zNs9SakzGuZo4xSd4XqVqWZOGmIkHOxG+vgt5uB7vT39trGmg26m2tgnHAKckXQONEudvET",
+	"Ban86jxmn+XW47L/fpvmPQuu9KrWxirD0re3HUup1v6p7A7OHq+AkHi4CQJk3zcIvcZ5/8FbMO47WJsU",
+	"epWzZL43bk6BjyK26+znpNQkG4ouqCEEOBWAMEU4CCCR1ptSSXFdguhVJmap3BS9mcZSOQcjEizEnEvP",
+	"QXJMRYakfshtbC5HsKNMe1i/ecogsQeinakYC88mtEgM+lJ8YozUidLsJamvcaIm4FXhzWWtYHaVK7nk",
+	"FmvXFKCqEFZnfzZ+bKke3GDns6ZWtr22gSZab+XnlrLXHxWZM6O9MpHgAJZRvgzSlIHMHJaMWLNscrcp",
+	"7CURJhQpS53faaWrPqXvB0WMQl6uc+bvS5qydw08V6/5rr2Cg52Ijbv3bBbt2xuCK/vV8S0dxuWjwk6/",
+	"mn12bDLG9SFY6LzDaEaDs7dlMGTWtkaMcs/MIc6Fa1vbl69cX9965tpVH22v37j20guX17d9tLy8vNg4",
+	"hKbfrRRSyufFX/FKwLzVC4/53vX1re1rz+kG5hy2t/rEoHlCtR7RVdbiNCI+8x6jRsxmnVYdbrqvaj/6",
+	"4O3DN391/Nt7h//5H4c/f+3onR98+eNfHr3/xy/f/8NX918/uvfb449+8NX9t45+/uEXr32MzK1lS9sk",
+	"JnT81f03emWhLBHZQXU3HQb94Q/fPvzzew30c+Gxbkmv6X7xya+OP3m/73TfevDZ24fv/tbwqC9VMy5r",
+	"PL7/w8Pf/fjw+3958Nnvjt95DU2IFCsL6r//T+l8EItzYXGovOOffXL0b6+ZiR+9+eHh9988/ul3ymiP",
+	"fvSpmvsHHx/ef/Xw3Xvm0YPP//v4AyUIDz7786A213ZTpsm4oemeixCFPKOlH6b2Qw5Hb337+PPf5Wua",
+	"C1DnMYcapZ//8Ojndhe0XXpM0yhSeSVvVfIU2k7juy/K/PK9n3xx797xO68p+n7yX4evf/fo3nt9Bar7",
+	"po7DT37x5cdvuS+ANuf+s9szHO/++vdf/OHDL+796ej3/2QWwuEPYiFvJSF2VsOOPnj18N13jn72h6N/",
+	"/bSbew3aYrzXrSAU7F9/dCoFERM6G8en3zsVjruZqnXe+Hlx8Mjh69998Je3cz3UonDnRea+EPS8kDnv",
+	"8zxTZNkVm6boxKhL2O597/ijH+TS+tX915/f2OoPv5CD7pU6lTRU0HSu0RmicV7ievZouoTgVGhsrtit",
+	"Og/f/tGDzz4+/NOHD/7ywRev/vPxT79jBe6d11CcfnX/dSMQK/Pjm2PTtrgN86PrXKtzQNdr454OXftX",
+	"gZR78dG/zGkX2m6VMXbu+M0/Hr36bc/3gKpE4otecTuOkDoX4fle+UNP2ih7d1yI8vt92w3k0evvHr75",
+	"y24D2XWHcPn9w3d/cfjuu1++9r0TyazuiOsg9NXPu6nsvJvYSMLhT35z+Pq/H7/3G+On5JcTf3X/DeUh",
+	"f/BG1T1GR+//8eitN5xS9OD+p8efvN+vjFwPpnSKa8T6XJuqPzbGGA8JxdJegSM5GaYSQtsQkN9wXvsQ",
+	"ApH68LYatFUMKlBc3lj3fG8KXBjkjy0PlgdZ3xZOiLfqPb48WH7cMzcx6CVZKT7ZNgYtDSwB47Uqz817",
+	"GmT1ZthCw+r3HzPtnLaRTP0TJ0lEAg1h5VvCLJ3JBcz5JbKDZpTtunpWL4hqccR83xCMROu4lYKlS8UX",
+	"euzMG0mtlKsql04kZFc0NT7bY5r+YvulF9PkUuLfdVKOgJ82KM+Rh13fKHJwtP1rRFWu6nHO+Zsciuuy",
+	"2jUOpj9BNZKa9i9MGwAs/9JIkiQCe0Nm48MpVaa67sz1cn/sCgv3z04mO67nPahmUSRP4eDhLW3Xsl6r",
+	"Mzm767MwIJGu4zxxlvRVPjrroOoKDrNr6Q3uSw8P91ZR29d9wlXx1qtsWyHrAtqiN1ZesTddHszUIA2R",
+	"j/PvQqkGzChCWAgWkJrcI6dCeRrq4qguaM2+uKY0O8cxmMTsi694RBFib4AxZ5wrN3SWZdcv8foU1x8c",
+	"3Hl4eyCrNvfYAVrNhJZNWvqeeHjS16CGMolG6v5bh/Vya8ia8s1nMkM4VziI1Di6bi29qZ8jnN3ZiBjP",
+	"7l5sEKKbEXcnwAERiSIYKdsxaoioAdnUz39XojmXOJhF+utRz2jB0IUjDjjcL76ZoKQjl93FvzItnoky",
+	"RYRmFM9U530cwASPlbcOYYsrOJ8T6NDSjvsAbMNr+VvEesNkt6HaHWM/z1bwN79S+sKsi33n+PpbG2b9",
+	"fRs39sE8t5o3iXmKQKQvQRCmftZChHp6Zd9NQulbikXsXf6t9K1B/bXCO45jt40auiJHN9h0UHTTPncR",
+	"pcCV6MH6L/3jna9LX80fJLSGB46vHrbGB1smJig+laaqpwqIvbrdV6VUmoUOeV1xVjTwcAKBrz0G6Glf",
+	"/u4d/xIvMmuW5d/aY4D8Ov2SJDcMx8or5SrXQVcKpXYTWx9XqHZN19+yP6Tn3L002mx+jV55T3/ckNkt",
+	"BysqqVv2t+vaiiUVZfV3JQq9tZbp0Wtqra9dPB6y+nqOleWvTXOxRLcd2cflN1SGIYgAU5UESodLdRu+",
+	"YjrMWpWXaZxdm4C+++rcJKT2Zdpu139im3mrXDAgUKBJNVNjQqHIizNOL//5VB8XyL/jWjgglndEsVGD",
+	"cuViyl+HPU/+OL5562JSZRqGaEd6vGVUk1XNWsC5z3T2JLOvTnR9B6RTi1c/JGJQmO8euaKxaglH+58p",
+	"j7xVbyJlsrqyErEAR4qJq5cGlwbewZ2D/xkAmWwm29OVAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -3,3 +3,4 @@ package cpusim
 //go:generate go tool oapi-codegen -config api/collector-codegen.yaml api/collector.openapi.yaml
 //go:generate go tool oapi-codegen -config api/dashboard-codegen.yaml api/dashboard.openapi.yaml
 //go:generate go tool oapi-codegen -config api/requester-codegen.yaml api/requester.openapi.yaml
+//go:generate protoc --go_out=. --go_opt=module=cpusim --go-grpc_out=. --go-grpc_opt=module=cpusim api/calculator.proto
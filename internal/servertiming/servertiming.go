@@ -0,0 +1,55 @@
+package servertiming
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Metric is one metric of a Server-Timing header
+type Metric struct {
+	Dur    float64 // duration in milliseconds, only meaningful when HasDur is set
+	HasDur bool    // the metric carried a valid, non-negative dur parameter
+	Desc   string  // the desc parameter, empty when absent
+}
+
+// Parse returns the metrics of a Server-Timing header by name, as sent by cpusim-server with every
+// calculate response (and as a gRPC trailer), e.g.
+//
+//	queue;dur=0.120, compute;dur=4.512, downstream;dur=8.004, cache;desc=hit
+//
+// Parameter values may be quoted, unknown parameters are ignored and so are invalid or negative
+// durations. A later metric of the same name replaces an earlier one. An empty header yields an
+// empty map.
+func Parse(header string) map[string]Metric {
+	metrics := make(map[string]Metric)
+	if strings.TrimSpace(header) == "" {
+		return metrics
+	}
+
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		name := strings.TrimSpace(parts[0])
+		if name == "" {
+			continue
+		}
+
+		var m Metric
+		for _, param := range parts[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok {
+				continue
+			}
+			value = strings.Trim(strings.TrimSpace(value), `"`)
+			switch strings.TrimSpace(key) {
+			case "dur":
+				if dur, err := strconv.ParseFloat(value, 64); err == nil && dur >= 0 {
+					m.Dur, m.HasDur = dur, true
+				}
+			case "desc":
+				m.Desc = value
+			}
+		}
+		metrics[name] = m
+	}
+	return metrics
+}
@@ -0,0 +1,55 @@
+package servertiming
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header string
+		want   map[string]Metric
+	}{
+		{"empty header", "", map[string]Metric{}},
+		{"blank header", "  ", map[string]Metric{}},
+		{"single metric", "compute;dur=4.512", map[string]Metric{
+			"compute": {Dur: 4.512, HasDur: true},
+		}},
+		{"multiple metrics", "queue;dur=0.120, compute;dur=4.512, downstream;dur=8, cache;desc=hit", map[string]Metric{
+			"queue":      {Dur: 0.12, HasDur: true},
+			"compute":    {Dur: 4.512, HasDur: true},
+			"downstream": {Dur: 8, HasDur: true},
+			"cache":      {Desc: "hit"},
+		}},
+		{"missing dur", "queue, compute;desc=calc", map[string]Metric{
+			"queue":   {},
+			"compute": {Desc: "calc"},
+		}},
+		{"quoted values", `compute;dur="2.5";desc="a b"`, map[string]Metric{
+			"compute": {Dur: 2.5, HasDur: true, Desc: "a b"},
+		}},
+		{"spaces around parameters", " compute ; dur = 5 ; desc = x ", map[string]Metric{
+			"compute": {Dur: 5, HasDur: true, Desc: "x"},
+		}},
+		{"invalid and negative dur", "queue;dur=abc, compute;dur=-1", map[string]Metric{
+			"queue":   {},
+			"compute": {},
+		}},
+		{"parameter without value", "compute;dur;desc=x", map[string]Metric{
+			"compute": {Desc: "x"},
+		}},
+		{"later metric wins", "compute;dur=1, compute;dur=2", map[string]Metric{
+			"compute": {Dur: 2, HasDur: true},
+		}},
+		{"empty entries", ", ,compute;dur=1,", map[string]Metric{
+			"compute": {Dur: 1, HasDur: true},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Parse(tc.header); !maps.Equal(got, tc.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tc.header, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultCgroupRoot is where the cgroup v2 hierarchy is mounted unless Config.CgroupRoot says otherwise
+const DefaultCgroupRoot = "/sys/fs/cgroup"
+
+// CgroupAuto makes the collector follow the cgroup of the monitored calculator process
+const CgroupAuto = "auto"
+
+// cgroupCPUSample is the cumulative cpu.stat counters used for rates
+type cgroupCPUSample struct {
+	usageUsec   uint64
+	nrPeriods   uint64
+	nrThrottled uint64
+	at          time.Time
+}
+
+// cgroupReader reads the cgroup v2 metrics of one cgroup, either a configured path or the cgroup
+// of the monitored process, which is looked up again when the process changes
+type cgroupReader struct {
+	root     string
+	procRoot string
+	path     string // configured path relative to root, or CgroupAuto
+	current  string // cgroup directory read at the previous data point
+	last     *cgroupCPUSample
+}
+
+// newCgroupReader creates a reader for path (relative to root, absolute paths below root are accepted)
+// or CgroupAuto. It returns nil when path is empty.
+func newCgroupReader(root, procRoot, path string) *cgroupReader {
+	if path == "" {
+		return nil
+	}
+	if root == "" {
+		root = DefaultCgroupRoot
+	}
+	if procRoot == "" {
+		procRoot = DefaultProcRoot
+	}
+	if path != CgroupAuto {
+		path = strings.TrimPrefix(filepath.Clean("/"+strings.TrimPrefix(path, root)), "/")
+	}
+	return &cgroupReader{root: root, procRoot: procRoot, path: path}
+}
+
+// collect reads the cgroup metrics at one data point. pid is a monitored process, used to find the
+// cgroup in auto mode (0 when none is running). A nil reader collects nothing.
+func (r *cgroupReader) collect(pid int32) (*CgroupMetrics, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	path := r.path
+	if path == CgroupAuto {
+		if pid == 0 {
+			return nil, nil
+		}
+		var err error
+		if path, err = processCgroup(r.procRoot, pid); err != nil {
+			return nil, err
+		}
+	}
+	dir := filepath.Join(r.root, path)
+
+	// A different cgroup (e.g. the service restarted in a new container) has unrelated counters
+	if dir != r.current {
+		r.current = dir
+		r.last = nil
+	}
+
+	cpuStat, err := readKeyedFile(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	sample := &cgroupCPUSample{
+		usageUsec:   cpuStat["usage_usec"],
+		nrPeriods:   cpuStat["nr_periods"],
+		nrThrottled: cpuStat["nr_throttled"],
+		at:          now,
+	}
+
+	metrics := &CgroupMetrics{
+		Path:          "/" + path,
+		NrPeriods:     sample.nrPeriods,
+		NrThrottled:   sample.nrThrottled,
+		ThrottledUsec: cpuStat["throttled_usec"],
+	}
+
+	if last := r.last; last != nil {
+		if elapsed := now.Sub(last.at); elapsed > 0 {
+			metrics.CPUUsagePercent = float64(counterDelta(sample.usageUsec, last.usageUsec)) / float64(elapsed.Microseconds()) * 100.0
+		}
+		if periods := counterDelta(sample.nrPeriods, last.nrPeriods); periods > 0 {
+			metrics.ThrottledPeriodsPercent = float64(counterDelta(sample.nrThrottled, last.nrThrottled)) / float64(periods) * 100.0
+		}
+	}
+	r.last = sample
+
+	// The cpu and memory controllers may not be enabled for the cgroup, their files are optional
+	if limit, err := readCPUMax(filepath.Join(dir, "cpu.max")); err == nil {
+		metrics.CPULimitCores = limit
+	}
+	if current, err := readSingleValue(filepath.Join(dir, "memory.current")); err == nil {
+		metrics.MemoryCurrentBytes = int64(current)
+	}
+	if limit, err := readSingleValue(filepath.Join(dir, "memory.max")); err == nil {
+		metrics.MemoryMaxBytes = int64(limit)
+	}
+	if events, err := readKeyedFile(filepath.Join(dir, "memory.events")); err == nil {
+		metrics.MemoryEvents = &MemoryEvents{
+			Low:     events["low"],
+			High:    events["high"],
+			Max:     events["max"],
+			OOM:     events["oom"],
+			OOMKill: events["oom_kill"],
+		}
+	}
+
+	return metrics, nil
+}
+
+// processCgroup returns the cgroup v2 path of a process from <procRoot>/<pid>/cgroup ("0::/path")
+func processCgroup(procRoot string, pid int32) (string, error) {
+	path := filepath.Join(procRoot, strconv.Itoa(int(pid)), "cgroup")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if cgroup, ok := strings.CutPrefix(line, "0::"); ok {
+			return strings.TrimPrefix(cgroup, "/"), nil
+		}
+	}
+	return "", fmt.Errorf("no cgroup v2 entry in %s", path)
+}
+
+// readKeyedFile parses a flat keyed cgroup file such as cpu.stat or memory.events ("key value" lines)
+func readKeyedFile(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in %s: %w", key, path, err)
+		}
+		values[key] = n
+	}
+	return values, scanner.Err()
+}
+
+// readSingleValue reads a single value cgroup file such as memory.current, "max" reads as 0
+func readSingleValue(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// readCPUMax returns the CPU quota of cpu.max ("$MAX $PERIOD") in cores, 0 when unlimited
+func readCPUMax(path string) (float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("unexpected cpu.max format: %q", strings.TrimSpace(string(data)))
+	}
+	if fields[0] == "max" {
+		return 0, nil
+	}
+	quota, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	period, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil || period <= 0 {
+		return 0, fmt.Errorf("invalid cpu.max period: %q", fields[1])
+	}
+	return quota / period, nil
+}
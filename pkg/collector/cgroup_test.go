@@ -0,0 +1,84 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCgroupReader_AutoFixture(t *testing.T) {
+	r := newCgroupReader("testdata/cgroup", "testdata/proc", CgroupAuto)
+
+	// Without a running calculator process there is no cgroup to read
+	if metrics, err := r.collect(0); metrics != nil || err != nil {
+		t.Fatalf("expected nothing without a process, got %+v, %v", metrics, err)
+	}
+
+	metrics, err := r.collect(4242)
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	if metrics.Path != "/cpusim.slice/cpusim.service" {
+		t.Errorf("unexpected cgroup path: %s", metrics.Path)
+	}
+	if metrics.CPULimitCores != 2 {
+		t.Errorf("expected a 2 core quota, got %v", metrics.CPULimitCores)
+	}
+	if metrics.NrPeriods != 400 || metrics.NrThrottled != 100 || metrics.ThrottledUsec != 1500000 {
+		t.Errorf("unexpected throttling counters: %+v", metrics)
+	}
+	if metrics.MemoryCurrentBytes != 52428800 || metrics.MemoryMaxBytes != 0 {
+		t.Errorf("unexpected memory: current %d, max %d", metrics.MemoryCurrentBytes, metrics.MemoryMaxBytes)
+	}
+	if metrics.MemoryEvents == nil || metrics.MemoryEvents.High != 12 || metrics.MemoryEvents.OOMKill != 1 {
+		t.Errorf("unexpected memory events: %+v", metrics.MemoryEvents)
+	}
+	// Rates need a previous point
+	if metrics.CPUUsagePercent != 0 || metrics.ThrottledPeriodsPercent != 0 {
+		t.Errorf("expected no rates on the first point, got %+v", metrics)
+	}
+}
+
+func TestCgroupReader_Rates(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "cpusim")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeCPUStat := func(usage, periods, throttled int) {
+		t.Helper()
+		content := fmt.Appendf(nil, "usage_usec %d\nnr_periods %d\nnr_throttled %d\nthrottled_usec 0\n", usage, periods, throttled)
+		if err := os.WriteFile(filepath.Join(dir, "cpu.stat"), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Absolute paths below the cgroup root are accepted
+	r := newCgroupReader(root, "", dir)
+
+	writeCPUStat(1_000_000, 100, 10)
+	if _, err := r.collect(0); err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	r.last.at = r.last.at.Add(-time.Second)
+
+	writeCPUStat(2_500_000, 110, 15)
+	metrics, err := r.collect(0)
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	// 1.5s of CPU in about 1s, 5 of the 10 new periods throttled
+	if metrics.CPUUsagePercent < 140 || metrics.CPUUsagePercent > 150 {
+		t.Errorf("expected about 150%% CPU, got %v", metrics.CPUUsagePercent)
+	}
+	if metrics.ThrottledPeriodsPercent != 50 {
+		t.Errorf("expected 50%% throttled periods, got %v", metrics.ThrottledPeriodsPercent)
+	}
+	if metrics.Path != "/cpusim" || metrics.MemoryEvents != nil {
+		t.Errorf("unexpected path or memory events: %+v", metrics)
+	}
+}
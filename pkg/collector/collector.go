@@ -3,27 +3,45 @@ package collector
 import (
 	"context"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/shirou/gopsutil/v3/common"
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/mem"
 	"github.com/shirou/gopsutil/v3/net"
-	"github.com/shirou/gopsutil/v3/process"
 )
 
 // Collector handles system metrics collection
 type Collector struct {
 	config           Config
-	lastNetStats     []net.IOCountersStat
-	lastCPUStats     []cpu.TimesStat
-	lastCPUTime      time.Time
+	interfaces       interfaceFilter
+	lastNetStats     map[string]net.IOCountersStat // by interface name
+	lastNetTime      time.Time
+	lastCPUTotal     cpu.TimesStat   // sum of lastPerCPUStats at the last sample with elapsed ticks
+	lastPerCPUStats  []cpu.TimesStat
+	processes        *processTracker
+	scheduler        *schedulerReader
+	cgroup           *cgroupReader
+	tcp              *tcpReader
+	disks            *diskReader
+	vmstat           *vmstatReader
+	publish          func(MetricDataPoint) // called with every collected point, e.g. to stream it live
 }
 
 // NewCollector creates a new metrics collector
 func NewCollector(config Config) *Collector {
 	return &Collector{
-		config: config,
+		config:     config,
+		interfaces: interfaceFilter{include: config.NetworkInclude, exclude: config.NetworkExclude},
+		processes:  newProcessTracker(config.CalculatorProcess),
+		scheduler:  newSchedulerReader(config.ProcRoot),
+		cgroup:     newCgroupReader(config.CgroupRoot, config.ProcRoot, config.CgroupPath),
+		tcp:        newTCPReader(config.ProcRoot, config.CalculatorPort),
+		disks:      newDiskReader(config.ProcRoot),
+		vmstat:     newVMStatReader(config.ProcRoot),
 	}
 }
 
@@ -36,13 +54,14 @@ func (c *Collector) Run(ctx context.Context) (*MetricsData, error) {
 	}
 
 	// Collection interval
-	interval := time.Duration(c.config.CollectionInterval) * time.Second
-	ticker := time.NewTicker(interval)
+	// A collection slower than the interval makes the ticker drop ticks rather than queue them up
+	ticker := time.NewTicker(c.config.Interval())
 	defer ticker.Stop()
 
 	// Collect metrics immediately at start
 	if metric, err := c.collectSinglePoint(ctx); err == nil {
 		data.Metrics = append(data.Metrics, *metric)
+		c.publishPoint(*metric)
 	}
 
 	// Continue collecting until context is done
@@ -61,22 +80,34 @@ func (c *Collector) Run(ctx context.Context) (*MetricsData, error) {
 				continue
 			}
 			data.Metrics = append(data.Metrics, *metric)
+			c.publishPoint(*metric)
 		}
 	}
 }
 
+// publishPoint hands a collected point to the publish hook, if any
+func (c *Collector) publishPoint(metric MetricDataPoint) {
+	if c.publish != nil {
+		c.publish(metric)
+	}
+}
+
 // collectSinglePoint collects a single metric data point
 func (c *Collector) collectSinglePoint(ctx context.Context) (*MetricDataPoint, error) {
 	metric := &MetricDataPoint{
 		Timestamp: time.Now(),
 	}
+	ctx = c.procContext(ctx)
 
-	// Collect CPU usage (best effort, don't fail on error)
-	cpuPercent, err := c.getCPUUsage(ctx)
+	// Collect CPU usage, its breakdown by state and per-core usage (best effort, don't fail on error)
+	perCPUStats, err := cpu.TimesWithContext(ctx, true)
 	if err != nil {
 		fmt.Printf("Warning: failed to get CPU usage: %v\n", err)
 	} else {
-		metric.CPUUsagePercent = cpuPercent
+		metric.CPUUsagePercent, metric.CPUBreakdown, metric.PerCPUUsagePercent = c.cpuUsage(perCPUStats)
+		for _, usage := range metric.PerCPUUsagePercent {
+			metric.MaxCPUUsagePercent = max(metric.MaxCPUUsagePercent, usage)
+		}
 	}
 
 	// Collect memory usage
@@ -88,149 +119,236 @@ func (c *Collector) collectSinglePoint(ctx context.Context) (*MetricDataPoint, e
 		metric.MemoryUsagePercent = memInfo.UsedPercent
 	}
 
+	// Collect page cache, swapping and page faults (best effort)
+	memory, err := c.vmstat.collect()
+	if err != nil {
+		fmt.Printf("Warning: failed to get memory detail: %v\n", err)
+	} else {
+		metric.Memory = memory
+	}
+
+	// Collect per-device disk I/O (best effort)
+	disks, err := c.disks.collect()
+	if err != nil {
+		fmt.Printf("Warning: failed to get disk I/O: %v\n", err)
+	} else {
+		metric.Disks = disks
+	}
+
 	// Collect network I/O (best effort)
-	networkIO, err := c.getNetworkIO(ctx)
+	networkIO, interfaces, err := c.getNetworkIO(ctx)
 	if err != nil {
 		fmt.Printf("Warning: failed to get network I/O: %v\n", err)
 	} else {
 		metric.NetworkIOBytes = *networkIO
+		metric.Interfaces = interfaces
 	}
 
-	// Check calculator service health by process
-	healthy := c.checkCalculatorProcessHealth(ctx)
-	metric.CalculatorServiceHealthy = healthy
-
-	return metric, nil
-}
-
-// getNetworkIO calculates network I/O rates per second
-func (c *Collector) getNetworkIO(ctx context.Context) (*NetworkIO, error) {
-	currentStats, err := net.IOCountersWithContext(ctx, false)
+	// Collect kernel TCP health (best effort)
+	tcp, err := c.tcp.collect()
 	if err != nil {
-		return nil, err
+		fmt.Printf("Warning: failed to get TCP metrics: %v\n", err)
+	} else {
+		metric.TCP = tcp
 	}
 
-	if len(currentStats) == 0 {
-		return &NetworkIO{}, nil
+	// Collect scheduler pressure (best effort)
+	scheduler, err := c.scheduler.collect()
+	if err != nil {
+		fmt.Printf("Warning: failed to get scheduler metrics: %v\n", err)
+	} else {
+		metric.Scheduler = scheduler
 	}
 
-	// If this is the first call, initialize lastNetStats and return zero values
-	if len(c.lastNetStats) == 0 {
-		c.lastNetStats = currentStats
-		return &NetworkIO{
-			BytesReceived:   0,
-			BytesSent:       0,
-			PacketsReceived: 0,
-			PacketsSent:     0,
-		}, nil
-	}
+	// Sample the calculator process(es), which also tells whether the service is healthy
+	metric.Process, metric.CalculatorServiceHealthy = c.processes.collect(ctx)
 
-	// Calculate the rate based on difference from last measurement
-	lastStat := c.lastNetStats[0]
-	networkIO := &NetworkIO{
-		BytesReceived:   int64(currentStats[0].BytesRecv - lastStat.BytesRecv),
-		BytesSent:       int64(currentStats[0].BytesSent - lastStat.BytesSent),
-		PacketsReceived: int64(currentStats[0].PacketsRecv - lastStat.PacketsRecv),
-		PacketsSent:     int64(currentStats[0].PacketsSent - lastStat.PacketsSent),
+	// Collect the cgroup metrics, in auto mode from the cgroup of the calculator process (best effort)
+	var pid int32
+	if metric.Process != nil && len(metric.Process.PIDs) > 0 {
+		pid = metric.Process.PIDs[0]
+	}
+	cgroup, err := c.cgroup.collect(pid)
+	if err != nil {
+		fmt.Printf("Warning: failed to get cgroup metrics: %v\n", err)
+	} else {
+		metric.Cgroup = cgroup
 	}
 
-	// Store current stats for next calculation
-	c.lastNetStats = currentStats
-
-	return networkIO, nil
+	return metric, nil
 }
 
-// checkCalculatorProcessHealth checks if the calculator process is running
-func (c *Collector) checkCalculatorProcessHealth(ctx context.Context) bool {
-	if c.config.CalculatorProcess == "" {
-		return false
+// procContext makes the gopsutil calls made with ctx read the configured procfs, like the readers of
+// this package do. Otherwise the CPU, memory, network and process metrics would describe the procfs the
+// collector runs in, and in auto mode the calculator PIDs found there would be looked up in another PID
+// namespace under ProcRoot. Without a ProcRoot gopsutil keeps honoring HOST_PROC.
+func (c *Collector) procContext(ctx context.Context) context.Context {
+	if c.config.ProcRoot == "" {
+		return ctx
 	}
+	return context.WithValue(ctx, common.EnvKey, common.EnvMap{common.HostProcEnvKey: c.config.ProcRoot})
+}
 
-	processes, err := process.ProcessesWithContext(ctx)
+// getNetworkIO calculates network I/O rates per second of the interfaces selected by the
+// include/exclude patterns, in total and per interface
+func (c *Collector) getNetworkIO(ctx context.Context) (*NetworkIO, []InterfaceIO, error) {
+	currentStats, err := net.IOCountersWithContext(ctx, true)
 	if err != nil {
-		return false
+		return nil, nil, err
 	}
 
-	for _, proc := range processes {
-		name, err := proc.NameWithContext(ctx)
-		if err != nil {
+	total, interfaces := c.networkRates(currentStats, time.Now())
+	return total, interfaces, nil
+}
+
+// networkRates turns per-interface counters into rates since the previous call. Interfaces without
+// a previous sample (first call, or created since, e.g. a container veth) report zero until the next call.
+func (c *Collector) networkRates(currentStats []net.IOCountersStat, currentTime time.Time) (*NetworkIO, []InterfaceIO) {
+	total := &NetworkIO{}
+	interfaces := make([]InterfaceIO, 0, len(currentStats))
+
+	timeDelta := currentTime.Sub(c.lastNetTime).Seconds()
+	last := c.lastNetStats
+	c.lastNetStats = make(map[string]net.IOCountersStat, len(currentStats))
+	c.lastNetTime = currentTime
+
+	for _, current := range currentStats {
+		if !c.interfaces.match(current.Name) {
 			continue
 		}
-
-		// Check if process name contains the calculator process name
-		if strings.Contains(name, c.config.CalculatorProcess) {
-			// Additional check to ensure the process is running
-			status, err := proc.StatusWithContext(ctx)
-			if err != nil {
-				continue
+		c.lastNetStats[current.Name] = current
+
+		iface := InterfaceIO{Name: current.Name}
+		if lastStat, ok := last[current.Name]; ok && timeDelta > 0 {
+			// Calculate the rate based on difference from last measurement, scaled to one second
+			// so that it does not depend on the collection interval
+			perSecond := func(current, last uint64) int64 {
+				return int64(float64(counterDelta(current, last)) / timeDelta)
 			}
-
-			// Consider process healthy if it's running, sleeping, or idle
-			// status is returned as []string, check the first element
-			if len(status) > 0 && (status[0] == "R" || status[0] == "S" || status[0] == "I") {
-				return true
+			iface.NetworkIO = NetworkIO{
+				BytesReceived:   perSecond(current.BytesRecv, lastStat.BytesRecv),
+				BytesSent:       perSecond(current.BytesSent, lastStat.BytesSent),
+				PacketsReceived: perSecond(current.PacketsRecv, lastStat.PacketsRecv),
+				PacketsSent:     perSecond(current.PacketsSent, lastStat.PacketsSent),
 			}
+			iface.ErrorsInPerSec = float64(counterDelta(current.Errin, lastStat.Errin)) / timeDelta
+			iface.ErrorsOutPerSec = float64(counterDelta(current.Errout, lastStat.Errout)) / timeDelta
+			iface.DropsInPerSec = float64(counterDelta(current.Dropin, lastStat.Dropin)) / timeDelta
+			iface.DropsOutPerSec = float64(counterDelta(current.Dropout, lastStat.Dropout)) / timeDelta
 		}
+
+		total.BytesReceived += iface.BytesReceived
+		total.BytesSent += iface.BytesSent
+		total.PacketsReceived += iface.PacketsReceived
+		total.PacketsSent += iface.PacketsSent
+		interfaces = append(interfaces, iface)
 	}
 
-	return false
+	slices.SortFunc(interfaces, func(a, b InterfaceIO) int { return strings.Compare(a.Name, b.Name) })
+	return total, interfaces
 }
 
-// getCPUUsage calculates CPU usage percentage based on time differences
-func (c *Collector) getCPUUsage(ctx context.Context) (float64, error) {
-	currentStats, err := cpu.TimesWithContext(ctx, false)
-	if err != nil {
-		return 0, err
-	}
+// interfaceFilter selects network interfaces by name with path.Match style globs
+type interfaceFilter struct {
+	include []string // empty means every interface
+	exclude []string
+}
 
-	currentTime := time.Now()
+// match reports whether the interface is selected: it matches an include pattern (if any)
+// and no exclude pattern
+func (f interfaceFilter) match(name string) bool {
+	matchAny := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(f.include) > 0 && !matchAny(f.include) {
+		return false
+	}
+	return !matchAny(f.exclude)
+}
 
-	// If we don't have previous stats, return 0 and store current stats
-	if len(c.lastCPUStats) == 0 {
-		c.lastCPUStats = currentStats
-		c.lastCPUTime = currentTime
-		return 0, nil
+// cpuUsage calculates the CPU usage percentage, the per-state breakdown and the usage of every
+// logical CPU since the previous call. Total and per-core usage come from the same per-CPU snapshot,
+// the total being the sum over all cores, so that they always cover the same interval.
+// It returns zero usage, a nil breakdown and no per-core usage on the first call and whenever the
+// number of CPUs changes (CPU hotplug).
+func (c *Collector) cpuUsage(currentStats []cpu.TimesStat) (float64, *CPUBreakdown, []float64) {
+	if len(currentStats) == 0 || len(c.lastPerCPUStats) != len(currentStats) {
+		c.lastPerCPUStats = slices.Clone(currentStats)
+		c.lastCPUTotal = sumCPUTimes(currentStats)
+		return 0, nil, nil
 	}
 
-	if len(currentStats) == 0 || len(c.lastCPUStats) == 0 {
-		return 0, nil
+	usage := make([]float64, len(currentStats))
+	for i := range currentStats {
+		// A core without elapsed ticks keeps its previous sample as the baseline
+		if b, ok := cpuBreakdown(currentStats[i], c.lastPerCPUStats[i]); ok {
+			usage[i] = busyPercent(b)
+			c.lastPerCPUStats[i] = currentStats[i]
+		}
 	}
 
-	// Calculate time difference
-	timeDelta := currentTime.Sub(c.lastCPUTime).Seconds()
-	if timeDelta <= 0 {
-		return 0, nil
+	total := sumCPUTimes(currentStats)
+	breakdown, ok := cpuBreakdown(total, c.lastCPUTotal)
+	if !ok {
+		return 0, nil, usage
 	}
+	c.lastCPUTotal = total
+
+	return busyPercent(breakdown), &breakdown, usage
+}
 
-	// Get current and last CPU stats (using first CPU core for overall system usage)
-	current := currentStats[0]
-	last := c.lastCPUStats[0]
+// sumCPUTimes returns the aggregate of per-CPU times, like the "cpu-total" entry
+func sumCPUTimes(stats []cpu.TimesStat) cpu.TimesStat {
+	total := cpu.TimesStat{CPU: "cpu-total"}
+	for _, s := range stats {
+		total.User += s.User
+		total.System += s.System
+		total.Nice += s.Nice
+		total.Iowait += s.Iowait
+		total.Irq += s.Irq
+		total.Softirq += s.Softirq
+		total.Steal += s.Steal
+		total.Idle += s.Idle
+		total.Guest += s.Guest
+		total.GuestNice += s.GuestNice
+	}
+	return total
+}
 
+// cpuBreakdown returns the share of time spent in each state between two samples of the same CPU.
+// ok is false when no time elapsed between the samples.
+func cpuBreakdown(current, last cpu.TimesStat) (b CPUBreakdown, ok bool) {
 	// Calculate total time differences
 	totalCurrent := current.User + current.System + current.Nice + current.Iowait + current.Irq + current.Softirq + current.Steal + current.Idle
 	totalLast := last.User + last.System + last.Nice + last.Iowait + last.Irq + last.Softirq + last.Steal + last.Idle
 
 	totalDelta := totalCurrent - totalLast
 	if totalDelta <= 0 {
-		return 0, nil
+		return b, false
 	}
 
-	// Calculate idle time difference
-	idleDelta := current.Idle - last.Idle
-
-	// Calculate CPU usage percentage
-	cpuUsage := (1.0 - (idleDelta / totalDelta)) * 100.0
-
-	// Store current stats for next calculation
-	c.lastCPUStats = currentStats
-	c.lastCPUTime = currentTime
-
-	// Ensure the value is within valid range
-	if cpuUsage < 0 {
-		cpuUsage = 0
-	} else if cpuUsage > 100 {
-		cpuUsage = 100
+	// Counters can step back slightly when a CPU is re-onlined, clamp every share into range
+	share := func(cur, prev float64) float64 {
+		return min(max((cur-prev)/totalDelta*100.0, 0), 100)
 	}
+	return CPUBreakdown{
+		User:    share(current.User, last.User),
+		Nice:    share(current.Nice, last.Nice),
+		System:  share(current.System, last.System),
+		Iowait:  share(current.Iowait, last.Iowait),
+		Irq:     share(current.Irq, last.Irq),
+		Softirq: share(current.Softirq, last.Softirq),
+		Steal:   share(current.Steal, last.Steal),
+		Idle:    share(current.Idle, last.Idle),
+	}, true
+}
 
-	return cpuUsage, nil
+// busyPercent returns the CPU usage percentage of a breakdown, everything but idle
+func busyPercent(b CPUBreakdown) float64 {
+	return min(max(100.0-b.Idle, 0), 100)
 }
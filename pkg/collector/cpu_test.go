@@ -0,0 +1,131 @@
+package collector
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+func TestCPUBreakdown(t *testing.T) {
+	last := cpu.TimesStat{User: 10, System: 5, Idle: 85}
+
+	tests := []struct {
+		name    string
+		current cpu.TimesStat
+		want    CPUBreakdown
+		ok      bool
+	}{
+		{
+			name:    "busy in every state",
+			current: cpu.TimesStat{User: 40, Nice: 5, System: 15, Iowait: 10, Irq: 2, Softirq: 3, Steal: 5, Idle: 120},
+			want:    CPUBreakdown{User: 30, Nice: 5, System: 10, Iowait: 10, Irq: 2, Softirq: 3, Steal: 5, Idle: 35},
+			ok:      true,
+		},
+		{
+			name:    "idle",
+			current: cpu.TimesStat{User: 10, System: 5, Idle: 95},
+			want:    CPUBreakdown{Idle: 100},
+			ok:      true,
+		},
+		{
+			name:    "no elapsed ticks",
+			current: last,
+			ok:      false,
+		},
+		{
+			// A re-onlined CPU can report a counter below its previous value
+			name:    "counter stepping back",
+			current: cpu.TimesStat{User: 8, System: 5, Idle: 97},
+			want:    CPUBreakdown{User: 0, Idle: 100},
+			ok:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := cpuBreakdown(tt.current, last)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("cpuBreakdown = %+v, %v, want %+v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCPUUsage(t *testing.T) {
+	c := NewCollector(Config{})
+
+	first := []cpu.TimesStat{
+		{CPU: "cpu0", User: 100, Idle: 900},
+		{CPU: "cpu1", User: 100, Idle: 900},
+	}
+	if usage, breakdown, perCPU := c.cpuUsage(first); usage != 0 || breakdown != nil || perCPU != nil {
+		t.Fatalf("first call = %v, %+v, %v, want no usage yet", usage, breakdown, perCPU)
+	}
+
+	// cpu0 fully busy, cpu1 idle: the total is the average of the same snapshot
+	second := []cpu.TimesStat{
+		{CPU: "cpu0", User: 200, Idle: 900},
+		{CPU: "cpu1", User: 100, Idle: 1000},
+	}
+	usage, breakdown, perCPU := c.cpuUsage(second)
+	if !slices.Equal(perCPU, []float64{100, 0}) {
+		t.Errorf("per-CPU usage = %v, want [100 0]", perCPU)
+	}
+	if usage != 50 || breakdown == nil || breakdown.User != 50 || breakdown.Idle != 50 {
+		t.Errorf("total = %v, %+v, want 50%% user", usage, breakdown)
+	}
+
+	// The total always equals the mean of the per-core usage when every core had ticks
+	third := []cpu.TimesStat{
+		{CPU: "cpu0", User: 230, System: 20, Idle: 950},
+		{CPU: "cpu1", User: 110, Idle: 1090},
+	}
+	usage, _, perCPU = c.cpuUsage(third)
+	if mean := (perCPU[0] + perCPU[1]) / 2; math.Abs(usage-mean) > 1e-9 {
+		t.Errorf("total %v, per-CPU %v with mean %v", usage, perCPU, mean)
+	}
+
+	// A hotplugged CPU resets the baselines instead of mixing counters of different CPU sets
+	fourth := append(slices.Clone(third), cpu.TimesStat{CPU: "cpu2", User: 5000, Idle: 5000})
+	if usage, breakdown, perCPU := c.cpuUsage(fourth); usage != 0 || breakdown != nil || perCPU != nil {
+		t.Errorf("after hotplug = %v, %+v, %v, want no usage until the next sample", usage, breakdown, perCPU)
+	}
+	fifth := []cpu.TimesStat{
+		{CPU: "cpu0", User: 330, System: 20, Idle: 950},
+		{CPU: "cpu1", User: 110, Idle: 1190},
+		{CPU: "cpu2", User: 5100, Idle: 5000},
+	}
+	if usage, _, perCPU := c.cpuUsage(fifth); !slices.Equal(perCPU, []float64{100, 0, 100}) || math.Abs(usage-200.0/3) > 1e-9 {
+		t.Errorf("after hotplug = %v, %v, want 66.7%% and [100 0 100]", usage, perCPU)
+	}
+}
+
+func TestProcContext(t *testing.T) {
+	ctx := NewCollector(Config{ProcRoot: "testdata/proc"}).procContext(context.Background())
+
+	// gopsutil reads the CPU times and the process table of the configured procfs
+	times, err := cpu.TimesWithContext(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(times) != 2 || times[0].CPU != "cpu0" || times[0].User != 13932.8 {
+		t.Errorf("per-CPU times = %+v, want cpu0 and cpu1 of testdata/proc/stat", times)
+	}
+	pids, err := process.PidsWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(pids, []int32{4242}) {
+		t.Errorf("PIDs = %v, want [4242] of testdata/proc", pids)
+	}
+
+	// Without a ProcRoot the context is left alone
+	background := context.Background()
+	if got := NewCollector(Config{}).procContext(background); got != background {
+		t.Error("procContext changed the context without a ProcRoot")
+	}
+}
@@ -0,0 +1,117 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// diskSectorSize is the unit of the sector counters of /proc/diskstats, whatever the device's block size
+const diskSectorSize = 512
+
+// diskSample is the cumulative /proc/diskstats counters of one device
+type diskSample struct {
+	reads          uint64
+	sectorsRead    uint64
+	writes         uint64
+	sectorsWritten uint64
+	ioMs           uint64 // time spent with I/O in flight
+}
+
+// diskReader reads the per-device I/O of /proc/diskstats from a procfs root.
+// Loop and RAM disks are skipped, as are devices that never completed an I/O.
+type diskReader struct {
+	root   string
+	last   map[string]diskSample // by device name
+	lastAt time.Time
+}
+
+// newDiskReader creates a reader for the procfs mounted at root, empty means DefaultProcRoot
+func newDiskReader(root string) *diskReader {
+	if root == "" {
+		root = DefaultProcRoot
+	}
+	return &diskReader{root: root}
+}
+
+// collect reads the disk I/O at one data point. Devices without a previous sample (first point,
+// or attached since) report zero until the next one.
+func (r *diskReader) collect() ([]DiskIO, error) {
+	current, err := readDiskstats(filepath.Join(r.root, "diskstats"))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	elapsed := now.Sub(r.lastAt)
+
+	disks := make([]DiskIO, 0, len(current))
+	for name, sample := range current {
+		disk := DiskIO{Name: name}
+		if last, ok := r.last[name]; ok && elapsed > 0 {
+			perSecond := func(current, last uint64) float64 {
+				return float64(counterDelta(current, last)) / elapsed.Seconds()
+			}
+			disk.ReadBytesPerSec = perSecond(sample.sectorsRead, last.sectorsRead) * diskSectorSize
+			disk.WriteBytesPerSec = perSecond(sample.sectorsWritten, last.sectorsWritten) * diskSectorSize
+			disk.ReadIOPS = perSecond(sample.reads, last.reads)
+			disk.WriteIOPS = perSecond(sample.writes, last.writes)
+			disk.BusyPercent = min(perSecond(sample.ioMs, last.ioMs)/1000*100.0, 100)
+		}
+		disks = append(disks, disk)
+	}
+	r.last = current
+	r.lastAt = now
+
+	slices.SortFunc(disks, func(a, b DiskIO) int { return strings.Compare(a.Name, b.Name) })
+	return disks, nil
+}
+
+// readDiskstats parses /proc/diskstats, e.g.
+//
+//	259       0 nvme0n1 53482 12087 4190306 9621 281347 171370 11295818 240931 0 166072 256290 ...
+//
+// where the fields after the name are reads, merged reads, sectors read, ms reading, writes,
+// merged writes, sectors written, ms writing, I/Os in flight and ms with I/O in flight
+func readDiskstats(path string) (map[string]diskSample, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	samples := make(map[string]diskSample)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 13 {
+			continue
+		}
+		name := fields[2]
+		if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+			continue
+		}
+
+		var values [10]uint64
+		for i := range values {
+			if values[i], err = strconv.ParseUint(fields[3+i], 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid diskstats field %d of %s: %w", 4+i, name, err)
+			}
+		}
+		if values[0] == 0 && values[4] == 0 {
+			continue
+		}
+		samples[name] = diskSample{
+			reads:          values[0],
+			sectorsRead:    values[2],
+			writes:         values[4],
+			sectorsWritten: values[6],
+			ioMs:           values[9],
+		}
+	}
+	return samples, scanner.Err()
+}
@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskReader_Fixture(t *testing.T) {
+	disks, err := newDiskReader("testdata/proc").collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	// Loop and RAM disks and idle devices are skipped, the rest sorted by name
+	want := []string{"dm-0", "nvme0n1", "nvme0n1p1"}
+	if len(disks) != len(want) {
+		t.Fatalf("unexpected disks: %+v", disks)
+	}
+	for i, disk := range disks {
+		if disk.Name != want[i] {
+			t.Errorf("disk %d = %s, want %s", i, disk.Name, want[i])
+		}
+		// Rates need a previous point
+		if disk != (DiskIO{Name: disk.Name}) {
+			t.Errorf("expected no rates on the first point, got %+v", disk)
+		}
+	}
+
+	if _, err := newDiskReader("testdata/missing").collect(); err == nil {
+		t.Error("expected an error for a missing proc root")
+	}
+}
+
+func TestDiskReader_Rates(t *testing.T) {
+	root := t.TempDir()
+	writeDiskstats := func(reads, sectorsRead, writes, sectorsWritten, ioMs int) {
+		t.Helper()
+		content := fmt.Appendf(nil, " 259 0 nvme0n1 %d 0 %d 0 %d 0 %d 0 0 %d 0\n", reads, sectorsRead, writes, sectorsWritten, ioMs)
+		if err := os.WriteFile(filepath.Join(root, "diskstats"), content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := newDiskReader(root)
+
+	writeDiskstats(100, 1000, 200, 2000, 500)
+	if _, err := r.collect(); err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	r.lastAt = r.lastAt.Add(-time.Second)
+
+	// 2048 sectors read and 4096 written in about 1s, busy for 250ms of it
+	writeDiskstats(150, 3048, 300, 6096, 750)
+	disks, err := r.collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	if len(disks) != 1 {
+		t.Fatalf("unexpected disks: %+v", disks)
+	}
+
+	disk := disks[0]
+	approx := func(name string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > want*0.01 {
+			t.Errorf("%s = %v, want about %v", name, got, want)
+		}
+	}
+	approx("read bytes", disk.ReadBytesPerSec, 1<<20)
+	approx("write bytes", disk.WriteBytesPerSec, 2<<20)
+	approx("read IOPS", disk.ReadIOPS, 50)
+	approx("write IOPS", disk.WriteIOPS, 100)
+	approx("busy", disk.BusyPercent, 25)
+}
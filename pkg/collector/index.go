@@ -0,0 +1,117 @@
+package collector
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// indexFileName is the experiment index in the storage directory. It does not end in .json so that
+// exp.FileStorage.List does not take it for an experiment.
+const indexFileName = "index.jsonl"
+
+// Experiment statuses of an ExperimentSummary
+const (
+	StatusRunning = "running"
+	StatusStopped = "stopped" // stopped on request
+	StatusTimeout = "timeout" // ran until its timeout
+)
+
+// ExperimentSummary is the index entry of an experiment, enough to list experiments without
+// decoding their data
+type ExperimentSummary struct {
+	ID         string    `json:"id"`
+	Status     string    `json:"status"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Duration   float64   `json:"duration"` // in seconds
+	DataPoints int       `json:"data_points"`
+	CPUMean    float64   `json:"cpu_mean"` // mean CPUUsagePercent, without the first point which has no baseline
+}
+
+// summarize builds the index entry of collected data
+func summarize(id, status string, data *MetricsData) ExperimentSummary {
+	summary := ExperimentSummary{
+		ID:         id,
+		Status:     status,
+		StartTime:  data.StartTime,
+		EndTime:    data.EndTime,
+		Duration:   data.Duration,
+		DataPoints: len(data.Metrics),
+	}
+
+	points := data.Metrics
+	if len(points) > 1 {
+		points = points[1:]
+	}
+	for _, point := range points {
+		summary.CPUMean += point.CPUUsagePercent
+	}
+	if len(points) > 0 {
+		summary.CPUMean /= float64(len(points))
+	}
+	return summary
+}
+
+// experimentIndex is an append-only file of ExperimentSummary lines, a later line for the same ID
+// (an experiment run again under the same name) replaces the earlier one
+type experimentIndex struct {
+	mu   sync.Mutex
+	path string
+}
+
+// newExperimentIndex creates the index of the experiments stored in dir
+func newExperimentIndex(dir string) *experimentIndex {
+	return &experimentIndex{path: filepath.Join(dir, indexFileName)}
+}
+
+// add appends summaries to the index
+func (i *experimentIndex) add(summaries ...ExperimentSummary) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	f, err := os.OpenFile(i.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	for _, summary := range summaries {
+		if err := encoder.Encode(summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load reads the index by experiment ID, a missing index is empty. Lines that do not parse, such as
+// one cut short by a crash, are skipped.
+func (i *experimentIndex) load() (map[string]ExperimentSummary, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	summaries := make(map[string]ExperimentSummary)
+	f, err := os.Open(i.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return summaries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var summary ExperimentSummary
+		if err := json.Unmarshal(scanner.Bytes(), &summary); err != nil || summary.ID == "" {
+			continue
+		}
+		summaries[summary.ID] = summary
+	}
+	return summaries, scanner.Err()
+}
@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cpusim/pkg/exp"
+	"github.com/rs/zerolog"
+)
+
+func TestSummarize(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := &MetricsData{
+		StartTime: start,
+		EndTime:   start.Add(3 * time.Second),
+		Duration:  3,
+		Metrics:   []MetricDataPoint{{CPUUsagePercent: 0}, {CPUUsagePercent: 40}, {CPUUsagePercent: 60}},
+	}
+
+	summary := summarize("exp-1", StatusTimeout, data)
+	// The first point has no CPU baseline and is left out of the mean
+	if summary.CPUMean != 50 || summary.DataPoints != 3 || summary.Status != StatusTimeout || !summary.EndTime.Equal(data.EndTime) {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+}
+
+func TestService_ListExperimentSummaries(t *testing.T) {
+	dir := t.TempDir()
+	service, err := NewService(dir, Config{CollectionIntervalMs: 1000}, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("Failed to create service: %v", err)
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, id := range []string{"exp-a", "exp-b", "exp-c"} {
+		data := &MetricsData{
+			StartTime: start.Add(time.Duration(i) * time.Hour),
+			EndTime:   start.Add(time.Duration(i)*time.Hour + time.Minute),
+			Duration:  60,
+			Metrics:   []MetricDataPoint{{}, {CPUUsagePercent: float64(30 - 10*i)}},
+		}
+		if err := service.fs.Save(id, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// exp-b is indexed as timed out, the others were stored without an index entry
+	if err := service.index.add(ExperimentSummary{ID: "exp-b", Status: StatusTimeout, StartTime: start.Add(time.Hour), DataPoints: 2, CPUMean: 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	summaries, total, err := service.ListExperimentSummaries(ListOptions{Descending: true})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if total != 3 || len(summaries) != 3 || summaries[0].ID != "exp-c" || summaries[2].ID != "exp-a" {
+		t.Fatalf("unexpected summaries: %d %+v", total, summaries)
+	}
+	if summaries[2].CPUMean != 30 || summaries[2].Status != StatusStopped {
+		t.Errorf("unexpected backfilled summary: %+v", summaries[2])
+	}
+
+	// The backfilled experiments are indexed now, their data is not needed anymore
+	if err := os.WriteFile(filepath.Join(dir, "exp-a.json"), []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	summaries, total, err = service.ListExperimentSummaries(ListOptions{SortBy: "cpuMean", Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if total != 3 || len(summaries) != 1 || summaries[0].ID != "exp-b" {
+		t.Errorf("unexpected page: %d %+v", total, summaries)
+	}
+
+	summaries, total, err = service.ListExperimentSummaries(ListOptions{StartedAfter: start.Add(30 * time.Minute), StartedBefore: start.Add(2 * time.Hour)})
+	if err != nil || total != 1 || summaries[0].ID != "exp-b" {
+		t.Errorf("unexpected time range result: %d %+v, %v", total, summaries, err)
+	}
+	if _, total, _ := service.ListExperimentSummaries(ListOptions{Status: StatusTimeout}); total != 1 {
+		t.Errorf("expected one timed out experiment, got %d", total)
+	}
+	if summaries, total, _ := service.ListExperimentSummaries(ListOptions{Offset: 5, Limit: 2}); total != 3 || len(summaries) != 0 {
+		t.Errorf("expected an empty page past the end, got %d %+v", total, summaries)
+	}
+}
+
+func TestService_IndexAfterSave(t *testing.T) {
+	dir := t.TempDir()
+	service, err := NewService(dir, Config{CollectionIntervalMs: MinCollectionIntervalMs}, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("Failed to create service: %v", err)
+	}
+
+	// An experiment that runs until its timeout is indexed as such once its data is saved
+	if err := service.StartExperiment("exp-timeout", 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	for service.GetStatus() == exp.Running {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := service.StopExperiment(); err != nil {
+		t.Fatal(err)
+	}
+	index, err := service.index.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary, ok := index["exp-timeout"]; !ok || summary.Status != StatusTimeout || summary.DataPoints == 0 {
+		t.Errorf("index entry = %+v, %v, want a timed out experiment with data", summary, ok)
+	}
+	if _, err := service.GetExperiment("exp-timeout"); err != nil {
+		t.Errorf("indexed experiment cannot be loaded: %v", err)
+	}
+
+	// Data that cannot be saved is never indexed
+	if err := service.StartExperiment("missing/exp-stopped", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.StopExperiment(); err != nil {
+		t.Fatal(err)
+	}
+	index, err = service.index.load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := index["missing/exp-stopped"]; ok || len(index) != 1 {
+		t.Errorf("index = %+v, want only exp-timeout", index)
+	}
+}
+
+func TestMetricsData_LegacyCollectionInterval(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		json string
+		want int
+	}{
+		{"milliseconds", `{"config":{"collection_interval_ms":250}}`, 250},
+		{"legacy seconds", `{"config":{"collection_interval":2,"calculator_process":"cpusim-server"}}`, 2000},
+		{"milliseconds win", `{"config":{"collection_interval_ms":100,"collection_interval":2}}`, 100},
+		{"no interval", `{"config":{}}`, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var data MetricsData
+			if err := json.Unmarshal([]byte(tc.json), &data); err != nil {
+				t.Fatal(err)
+			}
+			if data.Config.CollectionIntervalMs != tc.want {
+				t.Errorf("CollectionIntervalMs = %d, want %d", data.Config.CollectionIntervalMs, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package collector
+
+import (
+	"sync"
+	"time"
+)
+
+// LiveBufferSize is the number of recent data points of the current experiment kept for polling,
+// an hour at the default interval
+const LiveBufferSize = 3600
+
+// liveSubscriberBuffer is how far a stream subscriber may fall behind before points are dropped for it,
+// so that a slow client never delays the collection
+const liveSubscriberBuffer = 64
+
+// LivePoint is a data point of the current experiment with its sequence number. Sequence numbers
+// start at 0 with every experiment, a gap tells a subscriber it missed points.
+type LivePoint struct {
+	Seq   int
+	Point MetricDataPoint
+}
+
+// liveFeed keeps the recent data points of the current experiment and fans new ones out to subscribers.
+// The points stay available after the experiment ends, until the next one starts.
+type liveFeed struct {
+	mu           sync.Mutex
+	experimentID string
+	startedAt    time.Time
+	running      bool
+	next         int         // sequence number of the next point
+	points       []LivePoint // the last LiveBufferSize points, oldest first
+	subscribers  map[chan LivePoint]struct{}
+}
+
+// start resets the feed for a new experiment
+func (f *liveFeed) start(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closeSubscribers()
+	f.experimentID = id
+	f.startedAt = time.Now()
+	f.running = true
+	f.next = 0
+	f.points = nil
+}
+
+// finish marks the experiment as ended and closes the subscriber channels
+func (f *liveFeed) finish() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.running = false
+	f.closeSubscribers()
+}
+
+// publish records a new data point and sends it to every subscriber that has room for it
+func (f *liveFeed) publish(point MetricDataPoint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	live := LivePoint{Seq: f.next, Point: point}
+	f.next++
+	f.points = append(f.points, live)
+	if len(f.points) > LiveBufferSize {
+		f.points = f.points[len(f.points)-LiveBufferSize:]
+	}
+
+	for ch := range f.subscribers {
+		select {
+		case ch <- live:
+		default:
+		}
+	}
+}
+
+// latest returns up to n of the most recent points of experiment id, oldest first.
+// ok is false when id is not the current experiment.
+func (f *liveFeed) latest(id string, n int) (points []LivePoint, running bool, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if id == "" || id != f.experimentID {
+		return nil, false, false
+	}
+	start := max(len(f.points)-n, 0)
+	return append([]LivePoint{}, f.points[start:]...), f.running, true
+}
+
+// current returns the summary of the running experiment, ok is false when none is running
+func (f *liveFeed) current() (summary ExperimentSummary, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.running {
+		return ExperimentSummary{}, false
+	}
+	return ExperimentSummary{
+		ID:         f.experimentID,
+		Status:     StatusRunning,
+		StartTime:  f.startedAt,
+		Duration:   time.Since(f.startedAt).Seconds(),
+		DataPoints: f.next,
+	}, true
+}
+
+// subscribe registers a subscriber for the points of experiment id while it is running. The channel
+// is closed when the experiment ends or cancel is called. ok is false when id is not running.
+func (f *liveFeed) subscribe(id string) (points <-chan LivePoint, cancel func(), ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if id == "" || id != f.experimentID || !f.running {
+		return nil, nil, false
+	}
+	ch := make(chan LivePoint, liveSubscriberBuffer)
+	if f.subscribers == nil {
+		f.subscribers = make(map[chan LivePoint]struct{})
+	}
+	f.subscribers[ch] = struct{}{}
+
+	cancel = func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+	return ch, cancel, true
+}
+
+// closeSubscribers closes and forgets every subscriber channel, f.mu must be held
+func (f *liveFeed) closeSubscribers() {
+	for ch := range f.subscribers {
+		close(ch)
+	}
+	clear(f.subscribers)
+}
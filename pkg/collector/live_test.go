@@ -0,0 +1,62 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLiveFeed(t *testing.T) {
+	var f liveFeed
+	if _, _, ok := f.subscribe("exp-1"); ok {
+		t.Fatal("expected no subscription before the experiment starts")
+	}
+
+	f.start("exp-1")
+	points, cancel, ok := f.subscribe("exp-1")
+	if !ok {
+		t.Fatal("expected a subscription to the running experiment")
+	}
+	defer cancel()
+	if _, _, ok := f.subscribe("exp-2"); ok {
+		t.Error("expected no subscription to another experiment")
+	}
+
+	start := time.Now()
+	for i := range 3 {
+		f.publish(MetricDataPoint{Timestamp: start.Add(time.Duration(i) * time.Second)})
+	}
+	for i := range 3 {
+		if p := <-points; p.Seq != i || !p.Point.Timestamp.Equal(start.Add(time.Duration(i)*time.Second)) {
+			t.Errorf("point %d = %+v", i, p)
+		}
+	}
+
+	latest, running, ok := f.latest("exp-1", 2)
+	if !ok || !running || len(latest) != 2 || latest[0].Seq != 1 || latest[1].Seq != 2 {
+		t.Errorf("unexpected latest points: %+v, running %v, ok %v", latest, running, ok)
+	}
+
+	// Ending the experiment closes the stream but keeps the points
+	f.finish()
+	if _, open := <-points; open {
+		t.Error("expected the stream to be closed when the experiment ends")
+	}
+	if latest, running, ok := f.latest("exp-1", 10); !ok || running || len(latest) != 3 {
+		t.Errorf("unexpected latest points after the end: %+v, running %v, ok %v", latest, running, ok)
+	}
+
+	// A subscriber that falls behind misses points instead of blocking the collector
+	f.start("exp-2")
+	slow, cancelSlow, _ := f.subscribe("exp-2")
+	for range liveSubscriberBuffer + 10 {
+		f.publish(MetricDataPoint{})
+	}
+	if len(slow) != liveSubscriberBuffer {
+		t.Errorf("expected %d buffered points, got %d", liveSubscriberBuffer, len(slow))
+	}
+	cancelSlow()
+	cancelSlow()
+	if _, _, ok := f.latest("exp-1", 1); ok {
+		t.Error("expected the points of the previous experiment to be gone")
+	}
+}
@@ -0,0 +1,78 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+func TestInterfaceFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter interfaceFilter
+		want   map[string]bool
+	}{
+		{
+			name:   "everything by default",
+			filter: interfaceFilter{},
+			want:   map[string]bool{"lo": true, "eth0": true, "docker0": true},
+		},
+		{
+			name:   "exclude loopback and bridges",
+			filter: interfaceFilter{exclude: []string{"lo", "docker*", "veth*"}},
+			want:   map[string]bool{"lo": false, "eth0": true, "docker0": false, "veth12ab": false},
+		},
+		{
+			name:   "include data-plane NICs only",
+			filter: interfaceFilter{include: []string{"eth*", "ens*"}, exclude: []string{"eth1"}},
+			want:   map[string]bool{"lo": false, "eth0": true, "eth1": false, "ens5": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for iface, want := range tt.want {
+				if got := tt.filter.match(iface); got != want {
+					t.Errorf("match(%q) = %v, want %v", iface, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNetworkRates(t *testing.T) {
+	c := NewCollector(Config{CollectionIntervalMs: 500, NetworkExclude: []string{"lo"}})
+	start := time.Now()
+
+	first := []net.IOCountersStat{
+		{Name: "lo", BytesRecv: 1000, BytesSent: 1000},
+		{Name: "eth0", BytesRecv: 1000, BytesSent: 2000, PacketsRecv: 10, PacketsSent: 20, Dropin: 5},
+	}
+	total, interfaces := c.networkRates(first, start)
+	if *total != (NetworkIO{}) || len(interfaces) != 1 || interfaces[0].Name != "eth0" {
+		t.Fatalf("expected zero rates for eth0 only on the first call, got %+v %+v", total, interfaces)
+	}
+
+	second := []net.IOCountersStat{
+		{Name: "lo", BytesRecv: 9000, BytesSent: 9000},
+		{Name: "eth0", BytesRecv: 1500, BytesSent: 3000, PacketsRecv: 15, PacketsSent: 30, Dropin: 7, Errout: 1},
+		{Name: "veth1", BytesRecv: 100},
+	}
+	total, interfaces = c.networkRates(second, start.Add(500*time.Millisecond))
+
+	// Everything is per second, including drops and errors
+	want := NetworkIO{BytesReceived: 1000, BytesSent: 2000, PacketsReceived: 10, PacketsSent: 20}
+	if *total != want {
+		t.Errorf("total = %+v, want %+v", *total, want)
+	}
+	if len(interfaces) != 2 || interfaces[0].Name != "eth0" || interfaces[1].Name != "veth1" {
+		t.Fatalf("unexpected interfaces: %+v", interfaces)
+	}
+	if interfaces[0].NetworkIO != want || interfaces[0].DropsInPerSec != 4 || interfaces[0].ErrorsOutPerSec != 2 || interfaces[0].ErrorsInPerSec != 0 {
+		t.Errorf("unexpected eth0: %+v", interfaces[0])
+	}
+	if interfaces[1].NetworkIO != (NetworkIO{}) {
+		t.Errorf("expected zero rates for a new interface, got %+v", interfaces[1])
+	}
+}
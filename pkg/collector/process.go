@@ -0,0 +1,188 @@
+package collector
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// processCounters holds the cumulative counters of one process that are turned into rates
+type processCounters struct {
+	cpuSeconds  float64 // user + system
+	voluntary   int64
+	involuntary int64
+	readBytes   uint64
+	writeBytes  uint64
+}
+
+// trackedProcess is a matched calculator process, followed by PID between collections
+type trackedProcess struct {
+	proc       *process.Process
+	createTime int64 // milliseconds since epoch, tells a restarted process apart from a reused PID
+	last       *processCounters
+}
+
+// processScanInterval limits how often the process table is scanned while the calculator process
+// is missing, so that short collection intervals do not walk /proc on every data point
+const processScanInterval = time.Second
+
+// processTracker follows the processes whose name contains the configured calculator process name.
+// The process table is only scanned when nothing is tracked or a tracked process exited,
+// every other collection reads the tracked PIDs directly.
+type processTracker struct {
+	name     string
+	procs    map[int32]*trackedProcess
+	numCPU   int
+	lastTime time.Time
+	lastScan time.Time
+	exited   int // tracked processes that exited and have not been replaced yet
+	restarts int
+}
+
+// newProcessTracker creates a tracker for processes whose name contains name
+func newProcessTracker(name string) *processTracker {
+	return &processTracker{
+		name:  name,
+		procs: make(map[int32]*trackedProcess),
+	}
+}
+
+// collect samples the tracked processes and returns their metrics and whether any of them is healthy.
+// It returns nil metrics when no process name is configured.
+func (t *processTracker) collect(ctx context.Context) (*ProcessMetrics, bool) {
+	if t.name == "" {
+		return nil, false
+	}
+
+	now := time.Now()
+	metrics := &ProcessMetrics{PIDs: []int32{}}
+
+	// Drop processes that exited, IsRunning also compares the create time so a reused PID counts as exited
+	exited := 0
+	for pid, tp := range t.procs {
+		if running, err := tp.proc.IsRunningWithContext(ctx); err != nil || !running {
+			delete(t.procs, pid)
+			exited++
+		}
+	}
+	t.exited += exited
+
+	if (len(t.procs) == 0 || t.exited > 0) && now.Sub(t.lastScan) >= processScanInterval {
+		t.lastScan = now
+		// A process found after one exited replaces it, i.e. the service restarted under a new PID
+		if replaced := min(t.scan(ctx), t.exited); replaced > 0 {
+			t.exited -= replaced
+			t.restarts += replaced
+			metrics.Restarted = true
+		}
+	}
+
+	if t.numCPU == 0 {
+		if n, err := cpu.CountsWithContext(ctx, true); err == nil && n > 0 {
+			t.numCPU = n
+		}
+	}
+
+	elapsed := now.Sub(t.lastTime).Seconds()
+	healthy := false
+	for pid, tp := range t.procs {
+		metrics.PIDs = append(metrics.PIDs, pid)
+
+		if status, err := tp.proc.StatusWithContext(ctx); err == nil && len(status) > 0 {
+			switch status[0] {
+			case process.Running, process.Sleep, process.Idle:
+				healthy = true
+			}
+		}
+		if mem, err := tp.proc.MemoryInfoWithContext(ctx); err == nil {
+			metrics.RSSBytes += int64(mem.RSS)
+		}
+		if threads, err := tp.proc.NumThreadsWithContext(ctx); err == nil {
+			metrics.Threads += threads
+		}
+		if fds, err := tp.proc.NumFDsWithContext(ctx); err == nil {
+			metrics.OpenFDs += fds
+		}
+
+		current := readProcessCounters(ctx, tp.proc)
+		baseline := tp.last
+		// A process started since the previous collection did all of its work inside this interval
+		if baseline == nil && !t.lastTime.IsZero() && tp.createTime >= t.lastTime.UnixMilli() {
+			baseline = &processCounters{}
+		}
+		if baseline != nil && elapsed > 0 {
+			metrics.CPUPercent += max(current.cpuSeconds-baseline.cpuSeconds, 0) / elapsed * 100.0
+			metrics.VoluntaryCtxSwitchesPerSec += float64(max(current.voluntary-baseline.voluntary, 0)) / elapsed
+			metrics.InvoluntaryCtxSwitchesPerSec += float64(max(current.involuntary-baseline.involuntary, 0)) / elapsed
+			metrics.ReadBytesPerSec += float64(counterDelta(current.readBytes, baseline.readBytes)) / elapsed
+			metrics.WriteBytesPerSec += float64(counterDelta(current.writeBytes, baseline.writeBytes)) / elapsed
+		}
+		tp.last = &current
+	}
+
+	slices.Sort(metrics.PIDs)
+	if t.numCPU > 0 {
+		metrics.HostCPUPercent = metrics.CPUPercent / float64(t.numCPU)
+	}
+	metrics.Restarts = t.restarts
+	t.lastTime = now
+
+	return metrics, healthy
+}
+
+// scan walks the process table and starts tracking every matching process that is not tracked yet.
+// It returns the number of newly tracked processes.
+func (t *processTracker) scan(ctx context.Context) int {
+	processes, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return 0
+	}
+
+	found := 0
+	for _, proc := range processes {
+		if _, ok := t.procs[proc.Pid]; ok {
+			continue
+		}
+		name, err := proc.NameWithContext(ctx)
+		if err != nil || !strings.Contains(name, t.name) {
+			continue
+		}
+		createTime, err := proc.CreateTimeWithContext(ctx)
+		if err != nil {
+			continue
+		}
+		t.procs[proc.Pid] = &trackedProcess{proc: proc, createTime: createTime}
+		found++
+	}
+	return found
+}
+
+// readProcessCounters reads the cumulative counters of a process, counters that cannot be read
+// (e.g. I/O of another user's process) stay zero
+func readProcessCounters(ctx context.Context, proc *process.Process) processCounters {
+	var c processCounters
+	if times, err := proc.TimesWithContext(ctx); err == nil {
+		c.cpuSeconds = times.User + times.System
+	}
+	if switches, err := proc.NumCtxSwitchesWithContext(ctx); err == nil {
+		c.voluntary = switches.Voluntary
+		c.involuntary = switches.Involuntary
+	}
+	if io, err := proc.IOCountersWithContext(ctx); err == nil {
+		c.readBytes = io.ReadBytes
+		c.writeBytes = io.WriteBytes
+	}
+	return c
+}
+
+// counterDelta returns current - last, or 0 when the counter went backwards
+func counterDelta(current, last uint64) uint64 {
+	if current < last {
+		return 0
+	}
+	return current - last
+}
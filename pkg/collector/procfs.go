@@ -0,0 +1,237 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProcRoot is where procfs is mounted unless Config.ProcRoot says otherwise
+const DefaultProcRoot = "/proc"
+
+// pressureResources are the PSI files read from <proc root>/pressure
+var pressureResources = []string{"cpu", "memory", "io"}
+
+// schedstatSample is the run-queue counters of /proc/schedstat summed over all CPUs
+type schedstatSample struct {
+	runDelayNs uint64 // time tasks spent runnable but waiting for a CPU
+	timeslices uint64 // number of timeslices run
+	at         time.Time
+}
+
+// schedulerReader reads the scheduler pressure metrics from a procfs root.
+// Load average and /proc/stat are required, PSI and schedstat are reported when the kernel provides them.
+type schedulerReader struct {
+	root          string
+	lastSchedstat *schedstatSample
+}
+
+// newSchedulerReader creates a reader for the procfs mounted at root, empty means DefaultProcRoot
+func newSchedulerReader(root string) *schedulerReader {
+	if root == "" {
+		root = DefaultProcRoot
+	}
+	return &schedulerReader{root: root}
+}
+
+// collect reads the scheduler metrics at one data point
+func (r *schedulerReader) collect() (*SchedulerMetrics, error) {
+	metrics := &SchedulerMetrics{}
+
+	if err := readLoadAvg(r.root, metrics); err != nil {
+		return nil, err
+	}
+	if err := readProcsCounts(r.root, metrics); err != nil {
+		return nil, err
+	}
+
+	// PSI needs Linux 4.20+ with psi enabled, a missing file leaves the pressure unset
+	for _, resource := range pressureResources {
+		pressure, err := readPressure(filepath.Join(r.root, "pressure", resource))
+		if err != nil {
+			continue
+		}
+		switch resource {
+		case "cpu":
+			metrics.CPUPressure = pressure
+		case "memory":
+			metrics.MemoryPressure = pressure
+		case "io":
+			metrics.IOPressure = pressure
+		}
+	}
+
+	// schedstat needs CONFIG_SCHEDSTATS, its counters are turned into rates from the second data point on
+	if current, err := readSchedstat(r.root); err == nil {
+		if last := r.lastSchedstat; last != nil {
+			metrics.RunQueueWait = runQueueWait(*current, *last)
+		}
+		r.lastSchedstat = current
+	}
+
+	return metrics, nil
+}
+
+// readLoadAvg parses <root>/loadavg, e.g. "0.42 0.40 0.32 2/72 23481"
+func readLoadAvg(root string, metrics *SchedulerMetrics) error {
+	data, err := os.ReadFile(filepath.Join(root, "loadavg"))
+	if err != nil {
+		return err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) < 3 {
+		return fmt.Errorf("unexpected loadavg format: %q", strings.TrimSpace(string(data)))
+	}
+	loads := make([]float64, 3)
+	for i := range loads {
+		if loads[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return fmt.Errorf("invalid load average %q: %w", fields[i], err)
+		}
+	}
+	metrics.Load1, metrics.Load5, metrics.Load15 = loads[0], loads[1], loads[2]
+	return nil
+}
+
+// readProcsCounts reads the procs_running and procs_blocked lines of <root>/stat
+func readProcsCounts(root string, metrics *SchedulerMetrics) error {
+	// The intr line lists every IRQ and can be longer than a bufio.Scanner line on big hosts
+	data, err := os.ReadFile(filepath.Join(root, "stat"))
+	if err != nil {
+		return err
+	}
+
+	found := 0
+	for _, line := range strings.Split(string(data), "\n") {
+		name, value, ok := strings.Cut(line, " ")
+		if !ok || (name != "procs_running" && name != "procs_blocked") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+		if name == "procs_running" {
+			metrics.ProcsRunning = n
+		} else {
+			metrics.ProcsBlocked = n
+		}
+		found++
+	}
+	if found < 2 {
+		return fmt.Errorf("procs_running or procs_blocked missing from %s", filepath.Join(root, "stat"))
+	}
+	return nil
+}
+
+// readPressure parses a PSI file such as
+//
+//	some avg10=0.68 avg60=2.10 avg300=2.14 total=162168382
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func readPressure(path string) (*Pressure, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pressure := &Pressure{}
+	hasSome := false
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		stall := PressureStall{}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			var err error
+			switch key {
+			case "avg10":
+				stall.Avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stall.Avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stall.Avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				stall.TotalUs, err = strconv.ParseUint(value, 10, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s in %s: %w", key, path, err)
+			}
+		}
+		switch fields[0] {
+		case "some":
+			pressure.Some = stall
+			hasSome = true
+		case "full":
+			pressure.Full = &stall
+		}
+	}
+	if !hasSome {
+		return nil, fmt.Errorf("no some line in %s", path)
+	}
+	return pressure, nil
+}
+
+// readSchedstat sums the run_delay and pcount columns of the cpu lines in <root>/schedstat, e.g.
+//
+//	cpu0 0 0 0 0 0 0 1306398413464 87826386584 2216911
+func readSchedstat(root string) (*schedstatSample, error) {
+	f, err := os.Open(filepath.Join(root, "schedstat"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sample := &schedstatSample{at: time.Now()}
+	cpus := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+		runDelay, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run_delay %q: %w", fields[8], err)
+		}
+		timeslices, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pcount %q: %w", fields[9], err)
+		}
+		sample.runDelayNs += runDelay
+		sample.timeslices += timeslices
+		cpus++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if cpus == 0 {
+		return nil, fmt.Errorf("no cpu lines in %s", filepath.Join(root, "schedstat"))
+	}
+	return sample, nil
+}
+
+// runQueueWait turns two schedstat samples into run-queue wait rates, nil when no time elapsed
+func runQueueWait(current, last schedstatSample) *RunQueueWait {
+	elapsed := current.at.Sub(last.at)
+	if elapsed <= 0 {
+		return nil
+	}
+
+	waitNs := counterDelta(current.runDelayNs, last.runDelayNs)
+	wait := &RunQueueWait{
+		WaitingTasks: float64(waitNs) / float64(elapsed.Nanoseconds()),
+	}
+	if slices := counterDelta(current.timeslices, last.timeslices); slices > 0 {
+		wait.PerTimesliceMs = float64(waitNs) / float64(slices) / 1e6
+	}
+	return wait
+}
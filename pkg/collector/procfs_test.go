@@ -0,0 +1,98 @@
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchedulerReader_Fixture(t *testing.T) {
+	r := newSchedulerReader("testdata/proc")
+
+	metrics, err := r.collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	if metrics.Load1 != 3.5 || metrics.Load5 != 2.25 || metrics.Load15 != 1 {
+		t.Errorf("unexpected load averages: %v %v %v", metrics.Load1, metrics.Load5, metrics.Load15)
+	}
+	if metrics.ProcsRunning != 6 || metrics.ProcsBlocked != 2 {
+		t.Errorf("unexpected procs_running/procs_blocked: %d/%d", metrics.ProcsRunning, metrics.ProcsBlocked)
+	}
+
+	if metrics.CPUPressure == nil || metrics.CPUPressure.Some.Avg10 != 12.5 || metrics.CPUPressure.Some.TotalUs != 162168382 {
+		t.Errorf("unexpected cpu pressure: %+v", metrics.CPUPressure)
+	}
+	if metrics.MemoryPressure == nil || metrics.MemoryPressure.Full == nil || metrics.MemoryPressure.Full.Avg10 != 0.05 {
+		t.Errorf("unexpected memory pressure: %+v", metrics.MemoryPressure)
+	}
+	if metrics.IOPressure == nil || metrics.IOPressure.Full != nil || metrics.IOPressure.Some.Avg60 != 0.5 {
+		t.Errorf("unexpected io pressure: %+v", metrics.IOPressure)
+	}
+
+	// The first point has no schedstat baseline yet
+	if metrics.RunQueueWait != nil {
+		t.Errorf("expected no run-queue wait on the first point, got %+v", metrics.RunQueueWait)
+	}
+	if r.lastSchedstat == nil || r.lastSchedstat.runDelayNs != 87826386584+12173613416 || r.lastSchedstat.timeslices != 2216911+1999089 {
+		t.Errorf("unexpected schedstat sample: %+v", r.lastSchedstat)
+	}
+}
+
+func TestSchedulerReader_WithoutPSI(t *testing.T) {
+	metrics, err := newSchedulerReader("testdata/proc-nopsi").collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	if metrics.ProcsRunning != 1 {
+		t.Errorf("expected 1 running process, got %d", metrics.ProcsRunning)
+	}
+	if metrics.CPUPressure != nil || metrics.MemoryPressure != nil || metrics.IOPressure != nil || metrics.RunQueueWait != nil {
+		t.Errorf("expected no PSI or schedstat, got %+v", metrics)
+	}
+
+	if _, err := newSchedulerReader("testdata/missing").collect(); err == nil {
+		t.Error("expected an error for a missing proc root")
+	}
+}
+
+func TestRunQueueWait(t *testing.T) {
+	start := time.Now()
+	last := schedstatSample{runDelayNs: 1_000_000_000, timeslices: 100, at: start}
+	current := schedstatSample{runDelayNs: 3_000_000_000, timeslices: 1100, at: start.Add(time.Second)}
+
+	wait := runQueueWait(current, last)
+	if wait == nil {
+		t.Fatal("expected a run-queue wait")
+	}
+	// 2s of waiting in 1s means two tasks were waiting on average, 2ms before each of the 1000 timeslices
+	if wait.WaitingTasks != 2 || wait.PerTimesliceMs != 2 {
+		t.Errorf("unexpected run-queue wait: %+v", wait)
+	}
+
+	if runQueueWait(current, current) != nil {
+		t.Error("expected no run-queue wait without elapsed time")
+	}
+}
+
+func TestReadProcsCounts_LongIntrLine(t *testing.T) {
+	// Hosts with many IRQs have an intr line far beyond the default bufio.Scanner limit of 64 KiB
+	dir := t.TempDir()
+	intr := "intr 199292" + strings.Repeat(" 0", 100_000)
+	stat := "cpu  10 0 5 85 0 0 0 0 0 0\n" + intr + "\nctxt 38014093\nprocs_running 3\nprocs_blocked 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var metrics SchedulerMetrics
+	if err := readProcsCounts(dir, &metrics); err != nil {
+		t.Fatalf("readProcsCounts: %v", err)
+	}
+	if metrics.ProcsRunning != 3 || metrics.ProcsBlocked != 1 {
+		t.Errorf("procs_running/procs_blocked = %d/%d, want 3/1", metrics.ProcsRunning, metrics.ProcsBlocked)
+	}
+}
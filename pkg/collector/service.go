@@ -1,8 +1,12 @@
 package collector
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"cpusim/pkg/exp"
@@ -15,12 +19,21 @@ type Service struct {
 	exp.Manager[*MetricsData]
 
 	fs     exp.FileStorage[*MetricsData]
+	index  *experimentIndex
+	live   liveFeed
 	logger zerolog.Logger
 	config Config
 }
 
+// experimentIDParam passes the experiment ID to the collect function
+const experimentIDParam = "experimentId"
+
 // NewService creates a new collector service
 func NewService(storagePath string, config Config, logger zerolog.Logger) (*Service, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	fs, err := exp.NewFileStorage[*MetricsData](storagePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create file storage: %w", err)
@@ -28,6 +41,7 @@ func NewService(storagePath string, config Config, logger zerolog.Logger) (*Serv
 
 	s := &Service{
 		fs:     *fs,
+		index:  newExperimentIndex(storagePath),
 		logger: logger,
 		config: config,
 	}
@@ -35,11 +49,17 @@ func NewService(storagePath string, config Config, logger zerolog.Logger) (*Serv
 	// Create collector function with the service config
 	collectFunc := func(ctx context.Context, params gin.Params) (*MetricsData, error) {
 		s.logger.Info().
-			Int("collection_interval", s.config.CollectionInterval).
+			Int("collection_interval_ms", s.config.CollectionIntervalMs).
 			Str("calculator_process", s.config.CalculatorProcess).
 			Msg("Starting metrics collection experiment")
 
+		// Points are streamed live while the experiment runs, the data is only saved when it ends
+		id := params.ByName(experimentIDParam)
+		s.live.start(id)
+		defer s.live.finish()
+
 		collector := NewCollector(s.config)
+		collector.publish = s.live.publish
 		data, err := collector.Run(ctx)
 		if err != nil {
 			return nil, err
@@ -56,12 +76,24 @@ func NewService(storagePath string, config Config, logger zerolog.Logger) (*Serv
 	// Create and embed the manager
 	s.Manager = *exp.NewManager[*MetricsData](*fs, collectFunc, logger)
 
+	// Experiments are only indexed once their data file is saved, so that the index never lists
+	// an experiment that cannot be loaded
+	s.Manager.SetOnSaved(func(ctx context.Context, id string, data *MetricsData) {
+		status := StatusStopped
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			status = StatusTimeout
+		}
+		if err := s.index.add(summarize(id, status, data)); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to index experiment")
+		}
+	})
+
 	return s, nil
 }
 
 // StartExperiment starts a new metrics collection experiment
 func (s *Service) StartExperiment(id string, timeout time.Duration) error {
-	return s.Manager.Start(id, timeout, gin.Params{})
+	return s.Manager.Start(id, timeout, gin.Params{{Key: experimentIDParam, Value: id}})
 }
 
 // StopExperiment stops the current running experiment
@@ -73,3 +105,108 @@ func (s *Service) StopExperiment() error {
 func (s *Service) GetExperiment(id string) (*MetricsData, error) {
 	return s.fs.Load(id)
 }
+
+// LatestDataPoints returns up to n of the most recent data points of experiment id, oldest first.
+// They are available while it runs and after it ended until the next experiment starts; ok is false
+// for any other experiment, whose data has to be read with GetExperiment.
+func (s *Service) LatestDataPoints(id string, n int) (points []LivePoint, running bool, ok bool) {
+	return s.live.latest(id, n)
+}
+
+// SubscribeDataPoints streams the data points of the running experiment id as they are collected.
+// The channel is closed when the experiment ends or cancel is called, points are dropped for a
+// subscriber that falls behind. ok is false when id is not running.
+func (s *Service) SubscribeDataPoints(id string) (points <-chan LivePoint, cancel func(), ok bool) {
+	return s.live.subscribe(id)
+}
+
+// ListOptions selects and orders the experiments returned by ListExperimentSummaries
+type ListOptions struct {
+	Status        string    // StatusRunning, StatusStopped or StatusTimeout, empty for all
+	StartedAfter  time.Time // zero means no lower bound
+	StartedBefore time.Time // zero means no upper bound
+	SortBy        string    // "startTime" (default), "endTime", "id" or "cpuMean"
+	Descending    bool
+	Offset        int
+	Limit         int // 0 means no limit
+}
+
+// ListExperimentSummaries lists the stored experiments and the running one from the index, so that
+// no experiment data has to be decoded. Experiments stored without an index entry (e.g. by an older
+// version) are decoded once and added to the index. It returns the requested page and the number of
+// experiments matching the filters.
+func (s *Service) ListExperimentSummaries(opts ListOptions) ([]ExperimentSummary, int, error) {
+	stored, err := s.fs.List()
+	if err != nil {
+		return nil, 0, err
+	}
+	index, err := s.index.load()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var summaries, backfill []ExperimentSummary
+	for _, info := range stored {
+		summary, ok := index[info.ID]
+		if !ok {
+			data, err := s.fs.Load(info.ID)
+			if err != nil {
+				s.logger.Warn().Err(err).Str("experiment_id", info.ID).Msg("Skipping unreadable experiment")
+				continue
+			}
+			// Whether it was stopped or timed out is not recorded in the data
+			summary = summarize(info.ID, StatusStopped, data)
+			backfill = append(backfill, summary)
+		}
+		summaries = append(summaries, summary)
+	}
+	if len(backfill) > 0 {
+		if err := s.index.add(backfill...); err != nil {
+			s.logger.Error().Err(err).Msg("Failed to index experiments")
+		}
+	}
+
+	// The running experiment replaces an earlier one stored under the same ID
+	if running, ok := s.live.current(); ok {
+		summaries = slices.DeleteFunc(summaries, func(summary ExperimentSummary) bool { return summary.ID == running.ID })
+		summaries = append(summaries, running)
+	}
+
+	summaries = slices.DeleteFunc(summaries, func(summary ExperimentSummary) bool {
+		return (opts.Status != "" && summary.Status != opts.Status) ||
+			(!opts.StartedAfter.IsZero() && summary.StartTime.Before(opts.StartedAfter)) ||
+			(!opts.StartedBefore.IsZero() && !summary.StartTime.Before(opts.StartedBefore))
+	})
+	sortSummaries(summaries, opts.SortBy, opts.Descending)
+
+	total := len(summaries)
+	start := min(max(opts.Offset, 0), total)
+	end := total
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, total)
+	}
+	return summaries[start:end], total, nil
+}
+
+// sortSummaries orders experiment summaries by sortBy, ties by ID
+func sortSummaries(summaries []ExperimentSummary, sortBy string, descending bool) {
+	slices.SortStableFunc(summaries, func(a, b ExperimentSummary) int {
+		var c int
+		switch sortBy {
+		case "id":
+		case "endTime":
+			c = a.EndTime.Compare(b.EndTime)
+		case "cpuMean":
+			c = cmp.Compare(a.CPUMean, b.CPUMean)
+		default:
+			c = a.StartTime.Compare(b.StartTime)
+		}
+		if c == 0 {
+			c = strings.Compare(a.ID, b.ID)
+		}
+		if descending {
+			return -c
+		}
+		return c
+	})
+}
@@ -17,8 +17,8 @@ func TestService_BasicFlow(t *testing.T) {
 
 	// Define collector config
 	config := Config{
-		CollectionInterval: 1, // 1 second
-		CalculatorProcess:  "cpusim-server",
+		CollectionIntervalMs: 1000, // 1 second
+		CalculatorProcess:    "cpusim-server",
 	}
 
 	// Create service with config
@@ -86,8 +86,8 @@ func TestService_StopExperiment(t *testing.T) {
 	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
 
 	config := Config{
-		CollectionInterval: 1,
-		CalculatorProcess:  "cpusim-server",
+		CollectionIntervalMs: 1000,
+		CalculatorProcess:    "cpusim-server",
 	}
 
 	service, err := NewService(tempDir, config, logger)
@@ -0,0 +1,182 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// tcpStates maps the hex socket states of /proc/net/tcp to their names
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+	"0C": "NEW_SYN_RECV",
+}
+
+// tcpCounterKeys are the kernel counters reported as deltas, as "<section>.<name>" of /proc/net/snmp and /proc/net/netstat
+var tcpCounterKeys = []string{
+	"Tcp.ActiveOpens", "Tcp.PassiveOpens", "Tcp.AttemptFails", "Tcp.EstabResets",
+	"Tcp.InSegs", "Tcp.OutSegs", "Tcp.RetransSegs", "Tcp.InErrs", "Tcp.OutRsts",
+	"TcpExt.ListenOverflows", "TcpExt.ListenDrops", "TcpExt.TCPTimeouts",
+}
+
+// tcpReader reads the kernel TCP counters and the sockets of the calculator port from a procfs root.
+// /proc/net describes the network namespace of the collector, so it has to share it with the calculator.
+type tcpReader struct {
+	root string
+	port int // calculator port, 0 disables the socket state histogram
+	last map[string]uint64
+}
+
+// newTCPReader creates a reader for the procfs mounted at root, empty means DefaultProcRoot
+func newTCPReader(root string, port int) *tcpReader {
+	if root == "" {
+		root = DefaultProcRoot
+	}
+	return &tcpReader{root: root, port: port}
+}
+
+// collect reads the TCP metrics at one data point. Counters are deltas since the previous point,
+// so they are nil on the first one.
+func (r *tcpReader) collect() (*TCPMetrics, error) {
+	current := make(map[string]uint64)
+	for _, file := range []string{"snmp", "netstat"} {
+		if err := readNetstatFile(filepath.Join(r.root, "net", file), current); err != nil {
+			return nil, err
+		}
+	}
+
+	metrics := &TCPMetrics{}
+	if r.last != nil {
+		delta := func(key string) uint64 { return counterDelta(current[key], r.last[key]) }
+		metrics.Counters = &TCPCounters{
+			ActiveOpens:     delta("Tcp.ActiveOpens"),
+			PassiveOpens:    delta("Tcp.PassiveOpens"),
+			AttemptFails:    delta("Tcp.AttemptFails"),
+			EstabResets:     delta("Tcp.EstabResets"),
+			InSegs:          delta("Tcp.InSegs"),
+			OutSegs:         delta("Tcp.OutSegs"),
+			RetransSegs:     delta("Tcp.RetransSegs"),
+			InErrs:          delta("Tcp.InErrs"),
+			OutRsts:         delta("Tcp.OutRsts"),
+			ListenOverflows: delta("TcpExt.ListenOverflows"),
+			ListenDrops:     delta("TcpExt.ListenDrops"),
+			TCPTimeouts:     delta("TcpExt.TCPTimeouts"),
+		}
+	}
+	r.last = current
+
+	if r.port > 0 {
+		metrics.PortStates = make(map[string]int)
+		for _, file := range []string{"tcp", "tcp6"} {
+			backlog, err := readSocketStates(filepath.Join(r.root, "net", file), r.port, metrics.PortStates)
+			if err != nil && !(file == "tcp6" && os.IsNotExist(err)) {
+				return nil, err
+			}
+			metrics.ListenBacklog += backlog
+		}
+	}
+
+	return metrics, nil
+}
+
+// readNetstatFile parses the header/value line pairs of /proc/net/snmp or /proc/net/netstat, e.g.
+//
+//	Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens ...
+//	Tcp: 1 200 120000 -1 602 ...
+//
+// and stores the tcpCounterKeys it finds in values
+func readNetstatFile(path string, values map[string]uint64) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wanted := make(map[string]bool, len(tcpCounterKeys))
+	for _, key := range tcpCounterKeys {
+		wanted[key] = true
+	}
+
+	var header []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		// The header line comes first, the value line repeats its section prefix
+		if header == nil || header[0] != fields[0] {
+			header = fields
+			continue
+		}
+		section := strings.TrimSuffix(fields[0], ":")
+		for i := 1; i < len(fields) && i < len(header); i++ {
+			key := section + "." + header[i]
+			if !wanted[key] {
+				continue
+			}
+			value, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s in %s: %w", key, path, err)
+			}
+			values[key] = value
+		}
+		header = nil
+	}
+	return scanner.Err()
+}
+
+// readSocketStates counts the sockets of /proc/net/tcp or tcp6 whose local port is port by state
+// and returns the accept backlog of the listening ones (their rx_queue)
+func readSocketStates(path string, port int, states map[string]int) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	backlog := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // header
+	for scanner.Scan() {
+		//   sl  local_address rem_address   st tx_queue:rx_queue ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		_, localPort, ok := strings.Cut(fields[1], ":")
+		if !ok {
+			continue
+		}
+		if p, err := strconv.ParseUint(localPort, 16, 16); err != nil || int(p) != port {
+			continue
+		}
+
+		state, ok := tcpStates[fields[3]]
+		if !ok {
+			state = "UNKNOWN"
+		}
+		states[state]++
+
+		if state == "LISTEN" {
+			_, rxQueue, _ := strings.Cut(fields[4], ":")
+			if n, err := strconv.ParseUint(rxQueue, 16, 32); err == nil {
+				backlog += int(n)
+			}
+		}
+	}
+	return backlog, scanner.Err()
+}
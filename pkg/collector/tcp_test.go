@@ -0,0 +1,79 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTCPReader_Fixture(t *testing.T) {
+	r := newTCPReader("testdata/proc", 80)
+
+	metrics, err := r.collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	// Counters need a previous point
+	if metrics.Counters != nil {
+		t.Errorf("expected no counters on the first point, got %+v", metrics.Counters)
+	}
+	if r.last["Tcp.RetransSegs"] != 142 || r.last["TcpExt.ListenOverflows"] != 7 || r.last["TcpExt.TCPTimeouts"] != 31 {
+		t.Errorf("unexpected counter sample: %v", r.last)
+	}
+
+	// The client socket connecting to port 80 and the listener on 8080 are not counted
+	want := map[string]int{"LISTEN": 2, "ESTABLISHED": 2, "TIME_WAIT": 1, "CLOSE_WAIT": 1}
+	if len(metrics.PortStates) != len(want) {
+		t.Errorf("unexpected port states: %v", metrics.PortStates)
+	}
+	for state, n := range want {
+		if metrics.PortStates[state] != n {
+			t.Errorf("expected %d %s sockets, got %d", n, state, metrics.PortStates[state])
+		}
+	}
+	if metrics.ListenBacklog != 5 {
+		t.Errorf("expected a listen backlog of 5, got %d", metrics.ListenBacklog)
+	}
+
+	if metrics, err := newTCPReader("testdata/proc", 0).collect(); err != nil || metrics.PortStates != nil {
+		t.Errorf("expected no port states without a port, got %+v, %v", metrics, err)
+	}
+}
+
+func TestTCPReader_Deltas(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "net"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeCounters := func(retrans, overflows int) {
+		t.Helper()
+		snmp := fmt.Appendf(nil, "Tcp: ActiveOpens RetransSegs\nTcp: 10 %d\n", retrans)
+		netstat := fmt.Appendf(nil, "TcpExt: ListenOverflows ListenDrops\nTcpExt: %d %d\n", overflows, overflows)
+		if err := os.WriteFile(filepath.Join(root, "net", "snmp"), snmp, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, "net", "netstat"), netstat, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// No port, so net/tcp is not needed
+	r := newTCPReader(root, 0)
+
+	writeCounters(100, 3)
+	if _, err := r.collect(); err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	writeCounters(125, 5)
+	metrics, err := r.collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	want := TCPCounters{RetransSegs: 25, ListenOverflows: 2, ListenDrops: 2}
+	if metrics.Counters == nil || *metrics.Counters != want {
+		t.Errorf("counters = %+v, want %+v", metrics.Counters, want)
+	}
+}
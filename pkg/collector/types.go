@@ -2,13 +2,66 @@ package collector
 
 import (
 	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
 	"time"
 )
 
+// MinCollectionIntervalMs bounds the sampling cost: every data point reads /proc/stat, the network
+// counters and the tracked processes, which takes a few milliseconds on a busy host
+const MinCollectionIntervalMs = 50
+
 // Config defines the collector configuration
 type Config struct {
-	CollectionInterval int    `json:"collection_interval"` // in seconds
-	CalculatorProcess  string `json:"calculator_process"`  // process name to monitor
+	CollectionIntervalMs int    `json:"collection_interval_ms"`    // in milliseconds
+	CalculatorProcess    string `json:"calculator_process"`        // process name to monitor
+	CalculatorPort       int    `json:"calculator_port,omitempty"` // port of the calculator for the socket state histogram, 0 disables it
+	ProcRoot             string `json:"proc_root,omitempty"`       // procfs mount for all host and process metrics, empty means /proc
+	CgroupPath           string `json:"cgroup_path,omitempty"`     // cgroup v2 to monitor, "auto" follows the calculator process, empty disables it
+	CgroupRoot           string `json:"cgroup_root,omitempty"`     // cgroup v2 mount, empty means /sys/fs/cgroup
+
+	// Network interfaces counted in NetworkIOBytes and Interfaces, as path.Match globs (e.g. "eth*")
+	NetworkInclude []string `json:"network_include,omitempty"` // empty means every interface
+	NetworkExclude []string `json:"network_exclude,omitempty"` // applied after NetworkInclude
+}
+
+// Interval returns the collection interval as a duration
+func (c Config) Interval() time.Duration {
+	return time.Duration(c.CollectionIntervalMs) * time.Millisecond
+}
+
+// UnmarshalJSON also reads data saved before the interval was configurable in milliseconds,
+// whose collection_interval is in seconds
+func (c *Config) UnmarshalJSON(data []byte) error {
+	type Alias Config
+	legacy := struct {
+		*Alias
+		CollectionInterval float64 `json:"collection_interval"` // in seconds
+	}{Alias: (*Alias)(c)}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	if c.CollectionIntervalMs == 0 && legacy.CollectionInterval > 0 {
+		c.CollectionIntervalMs = int(legacy.CollectionInterval * 1000)
+	}
+	return nil
+}
+
+// Validate checks that the collection interval is within the supported range and the port and interface patterns are valid
+func (c Config) Validate() error {
+	if c.CollectionIntervalMs < MinCollectionIntervalMs {
+		return fmt.Errorf("collection interval must be at least %d ms, got %d ms", MinCollectionIntervalMs, c.CollectionIntervalMs)
+	}
+	if c.CalculatorPort < 0 || c.CalculatorPort > 65535 {
+		return fmt.Errorf("invalid calculator port %d", c.CalculatorPort)
+	}
+	for _, pattern := range slices.Concat(c.NetworkInclude, c.NetworkExclude) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid network interface pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
 }
 
 // MetricsData contains all collected metrics for an experiment
@@ -25,10 +78,167 @@ type MetricsData struct {
 type MetricDataPoint struct {
 	Timestamp                time.Time `json:"timestamp"`
 	CPUUsagePercent          float64   `json:"cpu_usage_percent"`
+	PerCPUUsagePercent       []float64 `json:"per_cpu_usage_percent,omitempty"` // one entry per logical CPU, empty on the first point
+	MaxCPUUsagePercent       float64   `json:"max_cpu_usage_percent"`           // hottest core, shows single-core saturation hidden by the average
+	CPUBreakdown             *CPUBreakdown `json:"cpu_breakdown,omitempty"`     // where the CPU time went, nil on the first point
 	MemoryUsageBytes         int64     `json:"memory_usage_bytes"`
 	MemoryUsagePercent       float64   `json:"memory_usage_percent"`
-	NetworkIOBytes           NetworkIO `json:"network_io_bytes"`
+	NetworkIOBytes           NetworkIO `json:"network_io_bytes"`                // sum over the selected interfaces
+	Interfaces               []InterfaceIO `json:"interfaces,omitempty"`      // selected interfaces by name
 	CalculatorServiceHealthy bool      `json:"calculator_service_healthy"`
+	Process                  *ProcessMetrics `json:"process,omitempty"` // calculator process(es), nil when no process name is configured
+	Scheduler                *SchedulerMetrics `json:"scheduler,omitempty"` // how far past CPU saturation the host is
+	Cgroup                   *CgroupMetrics `json:"cgroup,omitempty"`       // the calculator's cgroup, nil when not configured
+	TCP                      *TCPMetrics `json:"tcp,omitempty"`             // kernel TCP health
+	Disks                    []DiskIO `json:"disks,omitempty"`               // block devices by name
+	Memory                   *MemoryDetail `json:"memory,omitempty"`         // page cache, swapping and page faults
+}
+
+// CPUBreakdown is the share of all CPU time spent in each state since the previous sample, in percent.
+// The fields add up to 100; CPUUsagePercent is 100 - Idle.
+type CPUBreakdown struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Iowait  float64 `json:"iowait"`
+	Irq     float64 `json:"irq"`
+	Softirq float64 `json:"softirq"` // kernel networking shows up here
+	Steal   float64 `json:"steal"`   // time taken by the hypervisor for other guests
+	Idle    float64 `json:"idle"`
+}
+
+// ProcessMetrics describes the monitored calculator process(es) at one data point, summed over
+// all matching processes. Rates are per second since the previous data point; a process that was
+// already running when it was first seen contributes to them from its second data point on.
+type ProcessMetrics struct {
+	PIDs                         []int32 `json:"pids"`                             // matching processes, empty while the service is down
+	CPUPercent                   float64 `json:"cpu_percent"`                      // 100 means one core fully busy
+	HostCPUPercent               float64 `json:"host_cpu_percent"`                 // CPUPercent spread over all cores, comparable with CPUUsagePercent
+	RSSBytes                     int64   `json:"rss_bytes"`
+	Threads                      int32   `json:"threads"`
+	OpenFDs                      int32   `json:"open_fds"`
+	VoluntaryCtxSwitchesPerSec   float64 `json:"voluntary_ctx_switches_per_sec"`   // blocking on I/O, locks or sleeps
+	InvoluntaryCtxSwitchesPerSec float64 `json:"involuntary_ctx_switches_per_sec"` // preemption, a sign of CPU contention
+	ReadBytesPerSec              float64 `json:"read_bytes_per_sec"`               // storage I/O, zero without permission to read it
+	WriteBytesPerSec             float64 `json:"write_bytes_per_sec"`
+	Restarted                    bool    `json:"restarted"` // a tracked process exited and a new PID took its place since the previous point
+	Restarts                     int     `json:"restarts"`  // restarts since the collection started
+}
+
+// SchedulerMetrics shows scheduler pressure, which keeps growing after CPUUsagePercent saturates at 100
+type SchedulerMetrics struct {
+	Load1        float64 `json:"load1"`
+	Load5        float64 `json:"load5"`
+	Load15       float64 `json:"load15"`
+	ProcsRunning int     `json:"procs_running"` // runnable tasks, a value above the CPU count means a run queue
+	ProcsBlocked int     `json:"procs_blocked"` // tasks blocked on I/O
+
+	// Pressure stall information, nil when the kernel does not provide it
+	CPUPressure    *Pressure `json:"cpu_pressure,omitempty"`
+	MemoryPressure *Pressure `json:"memory_pressure,omitempty"`
+	IOPressure     *Pressure `json:"io_pressure,omitempty"`
+
+	// Run-queue wait from schedstat, nil on the first point or without CONFIG_SCHEDSTATS
+	RunQueueWait *RunQueueWait `json:"run_queue_wait,omitempty"`
+}
+
+// Pressure is the content of one /proc/pressure file
+type Pressure struct {
+	Some PressureStall  `json:"some"`           // share of time at least one task was stalled
+	Full *PressureStall `json:"full,omitempty"` // share of time all non-idle tasks were stalled, absent on older kernels
+}
+
+// PressureStall holds the stall time averages in percent and the total stall time
+type PressureStall struct {
+	Avg10   float64 `json:"avg10"`
+	Avg60   float64 `json:"avg60"`
+	Avg300  float64 `json:"avg300"`
+	TotalUs uint64  `json:"total_us"`
+}
+
+// RunQueueWait is the time tasks spent runnable but waiting for a CPU since the previous point
+type RunQueueWait struct {
+	WaitingTasks   float64 `json:"waiting_tasks"`     // average number of tasks waiting for a CPU
+	PerTimesliceMs float64 `json:"per_timeslice_ms"` // average wait before each timeslice
+}
+
+// CgroupMetrics describes a cgroup v2 at one data point. Inside a container the host-wide CPU usage
+// misleads, the cgroup shows what the service actually got and whether its CPU quota throttled it.
+type CgroupMetrics struct {
+	Path                    string  `json:"path"`                      // relative to the cgroup mount
+	CPUUsagePercent         float64 `json:"cpu_usage_percent"`         // since the previous point, 100 means one core fully busy
+	CPULimitCores           float64 `json:"cpu_limit_cores"`           // quota from cpu.max, 0 when unlimited
+	NrPeriods               uint64  `json:"nr_periods"`                // enforcement periods so far
+	NrThrottled             uint64  `json:"nr_throttled"`              // periods in which the quota ran out
+	ThrottledUsec           uint64  `json:"throttled_usec"`            // total time throttled
+	ThrottledPeriodsPercent float64 `json:"throttled_periods_percent"` // share of the periods since the previous point that were throttled
+	MemoryCurrentBytes      int64   `json:"memory_current_bytes"`
+	MemoryMaxBytes          int64   `json:"memory_max_bytes"` // 0 when unlimited
+	MemoryEvents            *MemoryEvents `json:"memory_events,omitempty"`
+}
+
+// MemoryEvents holds the cumulative counters of memory.events
+type MemoryEvents struct {
+	Low     uint64 `json:"low"`
+	High    uint64 `json:"high"` // reclaim forced by memory.high, a sign of throttled allocation
+	Max     uint64 `json:"max"`
+	OOM     uint64 `json:"oom"`
+	OOMKill uint64 `json:"oom_kill"`
+}
+
+// InterfaceIO is the network I/O of one interface. Rates are per second like NetworkIO; errors and
+// drops are fractional so that a few per interval do not round to zero.
+type InterfaceIO struct {
+	Name string `json:"name"`
+	NetworkIO
+	ErrorsInPerSec  float64 `json:"errors_in_per_sec"`
+	ErrorsOutPerSec float64 `json:"errors_out_per_sec"`
+	DropsInPerSec   float64 `json:"drops_in_per_sec"`
+	DropsOutPerSec  float64 `json:"drops_out_per_sec"`
+}
+
+// TCPMetrics tells whether the accept backlog overflowed or packets were retransmitted when latency explodes
+type TCPMetrics struct {
+	Counters      *TCPCounters   `json:"counters,omitempty"`    // since the previous point, nil on the first one
+	PortStates    map[string]int `json:"port_states,omitempty"` // sockets on the calculator port by state, e.g. "ESTABLISHED", "TIME_WAIT"
+	ListenBacklog int            `json:"listen_backlog"`        // connections waiting to be accepted on the calculator port
+}
+
+// TCPCounters are the kernel TCP counters of /proc/net/snmp and /proc/net/netstat since the previous data point
+type TCPCounters struct {
+	ActiveOpens     uint64 `json:"active_opens"`
+	PassiveOpens    uint64 `json:"passive_opens"`
+	AttemptFails    uint64 `json:"attempt_fails"`
+	EstabResets     uint64 `json:"estab_resets"`
+	InSegs          uint64 `json:"in_segs"`
+	OutSegs         uint64 `json:"out_segs"`
+	RetransSegs     uint64 `json:"retrans_segs"`
+	InErrs          uint64 `json:"in_errs"`
+	OutRsts         uint64 `json:"out_rsts"`
+	ListenOverflows uint64 `json:"listen_overflows"` // accept queue full
+	ListenDrops     uint64 `json:"listen_drops"`     // SYNs dropped on a listening socket, including overflows
+	TCPTimeouts     uint64 `json:"tcp_timeouts"`     // retransmission timer expirations
+}
+
+// DiskIO is the I/O of one block device from /proc/diskstats, as rates per second since the previous data point
+type DiskIO struct {
+	Name             string  `json:"name"`
+	ReadBytesPerSec  float64 `json:"read_bytes_per_sec"`
+	WriteBytesPerSec float64 `json:"write_bytes_per_sec"`
+	ReadIOPS         float64 `json:"read_iops"`    // completed reads per second
+	WriteIOPS        float64 `json:"write_iops"`   // completed writes per second
+	BusyPercent      float64 `json:"busy_percent"` // share of the time the device had I/O in flight
+}
+
+// MemoryDetail is the memory activity of /proc/vmstat. Rates are per second since the previous data point.
+type MemoryDetail struct {
+	PageCacheBytes    int64   `json:"page_cache_bytes"`
+	DirtyBytes        int64   `json:"dirty_bytes"` // page cache waiting to be written back
+	WritebackBytes    int64   `json:"writeback_bytes"`
+	SwapInPerSec      float64 `json:"swap_in_per_sec"`  // pages
+	SwapOutPerSec     float64 `json:"swap_out_per_sec"` // pages
+	MajorFaultsPerSec float64 `json:"major_faults_per_sec"` // faults that had to read from disk
+	MinorFaultsPerSec float64 `json:"minor_faults_per_sec"`
 }
 
 // NetworkIO represents network I/O statistics
@@ -0,0 +1,69 @@
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// vmstatSample is the cumulative /proc/vmstat counters turned into rates
+type vmstatSample struct {
+	swapIn      uint64 // pswpin, pages
+	swapOut     uint64 // pswpout, pages
+	faults      uint64 // pgfault, minor and major
+	majorFaults uint64 // pgmajfault
+	at          time.Time
+}
+
+// vmstatReader reads the memory activity of /proc/vmstat from a procfs root
+type vmstatReader struct {
+	root     string
+	pageSize int64
+	last     *vmstatSample
+}
+
+// newVMStatReader creates a reader for the procfs mounted at root, empty means DefaultProcRoot
+func newVMStatReader(root string) *vmstatReader {
+	if root == "" {
+		root = DefaultProcRoot
+	}
+	return &vmstatReader{root: root, pageSize: int64(os.Getpagesize())}
+}
+
+// collect reads the memory detail at one data point, rates are zero on the first one
+func (r *vmstatReader) collect() (*MemoryDetail, error) {
+	// /proc/vmstat has the same "key value" lines as the flat keyed cgroup files
+	values, err := readKeyedFile(filepath.Join(r.root, "vmstat"))
+	if err != nil {
+		return nil, err
+	}
+	sample := &vmstatSample{
+		swapIn:      values["pswpin"],
+		swapOut:     values["pswpout"],
+		faults:      values["pgfault"],
+		majorFaults: values["pgmajfault"],
+		at:          time.Now(),
+	}
+
+	metrics := &MemoryDetail{
+		PageCacheBytes: int64(values["nr_file_pages"]) * r.pageSize,
+		DirtyBytes:     int64(values["nr_dirty"]) * r.pageSize,
+		WritebackBytes: int64(values["nr_writeback"]) * r.pageSize,
+	}
+
+	if last := r.last; last != nil {
+		if elapsed := sample.at.Sub(last.at).Seconds(); elapsed > 0 {
+			perSecond := func(current, last uint64) float64 {
+				return float64(counterDelta(current, last)) / elapsed
+			}
+			majorFaults := perSecond(sample.majorFaults, last.majorFaults)
+			metrics.SwapInPerSec = perSecond(sample.swapIn, last.swapIn)
+			metrics.SwapOutPerSec = perSecond(sample.swapOut, last.swapOut)
+			metrics.MajorFaultsPerSec = majorFaults
+			metrics.MinorFaultsPerSec = max(perSecond(sample.faults, last.faults)-majorFaults, 0)
+		}
+	}
+	r.last = sample
+
+	return metrics, nil
+}
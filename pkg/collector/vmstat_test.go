@@ -0,0 +1,35 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVMStatReader(t *testing.T) {
+	r := newVMStatReader("testdata/proc")
+	r.pageSize = 4096
+
+	metrics, err := r.collect()
+	if err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	if metrics.PageCacheBytes != 1<<30 || metrics.DirtyBytes != 1<<20 || metrics.WritebackBytes != 64<<10 {
+		t.Errorf("unexpected page cache: %+v", metrics)
+	}
+	// Rates need a previous point
+	if metrics.SwapInPerSec != 0 || metrics.MajorFaultsPerSec != 0 || metrics.MinorFaultsPerSec != 0 {
+		t.Errorf("expected no rates on the first point, got %+v", metrics)
+	}
+
+	// Pretend the previous point was 2s ago with fewer faults and swapped pages
+	r.last = &vmstatSample{swapIn: 100, swapOut: 480, faults: 98765432 - 2021, majorFaults: 4321 - 21, at: r.last.at.Add(-2 * time.Second)}
+	if metrics, err = r.collect(); err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+	if metrics.SwapInPerSec < 9.9 || metrics.SwapInPerSec > 10 || metrics.SwapOutPerSec != 0 {
+		t.Errorf("unexpected swap rates: %+v", metrics)
+	}
+	if metrics.MajorFaultsPerSec < 10.4 || metrics.MajorFaultsPerSec > 10.5 || metrics.MinorFaultsPerSec < 999 || metrics.MinorFaultsPerSec > 1000 {
+		t.Errorf("unexpected fault rates: %+v", metrics)
+	}
+}
@@ -711,7 +711,7 @@ func (s *Service) executeExperimentGroup(groupID string, group *ExperimentGroup)
 		}
 
 		if len(experiments) > 0 {
-			group.QPSPoints[qpsIdx].Statistics = s.calculateCPUStats(experiments)
+			group.QPSPoints[qpsIdx].Statistics = s.calculateCPUStats(experiments, config.CPUBreakdown)
 			group.QPSPoints[qpsIdx].LatencyStats = s.calculateLatencyStats(experiments)
 		}
 		group.QPSPoints[qpsIdx].Status = "completed"
@@ -830,15 +830,20 @@ func (s *Service) GetExperimentGroupWithDetails(groupID string) (*ExperimentGrou
 	return group, experiments, nil
 }
 
-// calculateCPUStats calculates CPU statistics with confidence intervals for each host
-func (s *Service) calculateCPUStats(experiments []*ExperimentData) map[string]*CPUStats {
+// calculateCPUStats calculates CPU statistics with confidence intervals for each host.
+// withBreakdown also averages the per-state CPU time split reported by the collectors.
+func (s *Service) calculateCPUStats(experiments []*ExperimentData, withBreakdown bool) map[string]*CPUStats {
 	if len(experiments) == 0 {
 		s.logger.Warn().Msg("calculateCPUStats: no experiments")
 		return nil
 	}
 
 	// Group CPU metrics by host
-	hostMetrics := make(map[string][]float64) // key: host name, value: steady-state mean CPU for each experiment
+	hostMetrics := make(map[string][]float64)        // key: host name, value: steady-state mean CPU for each experiment
+	hostHottest := make(map[string][]float64)        // key: host name, value: steady-state mean hottest-core CPU for each experiment
+	hostBreakdown := make(map[string][]CPUBreakdown) // key: host name, value: steady-state mean CPU time split for each experiment
+	hostProcess := make(map[string][]float64)        // key: host name, value: steady-state mean calculator process CPU for each experiment
+	hostThrottled := make(map[string][]float64)      // key: host name, value: steady-state mean throttled cgroup periods for each experiment
 
 	for expIdx, exp := range experiments {
 		if exp.CollectorResults == nil {
@@ -857,22 +862,53 @@ func (s *Service) calculateCPUStats(experiments []*ExperimentData) map[string]*C
 
 			// Calculate steady-state mean for this experiment (last 90% of data)
 			metrics := result.Data.Metrics
-			steadyStateStart := len(metrics) / 10 // Skip first 10%
-			if steadyStateStart >= len(metrics) {
-				steadyStateStart = 0
-			}
+			steadyStateStart := steadyStateStartIndex(metrics)
 
-			var cpuSum float64
-			cpuCount := 0
+			var cpuSum, hottestSum, processSum, throttledSum float64
+			var breakdownSum CPUBreakdown
+			cpuCount, hottestCount, breakdownCount, processCount, throttledCount := 0, 0, 0, 0, 0
 			for i := steadyStateStart; i < len(metrics); i++ {
 				cpuSum += float64(metrics[i].SystemMetrics.CpuUsagePercent)
 				cpuCount++
+
+				// Points without per-core data (first point, older collectors) are skipped
+				if len(metrics[i].SystemMetrics.PerCpuUsagePercent) > 0 {
+					hottestSum += float64(metrics[i].SystemMetrics.MaxCpuUsagePercent)
+					hottestCount++
+				}
+
+				if withBreakdown && metrics[i].SystemMetrics.CpuBreakdown != nil {
+					breakdownSum.add(cpuBreakdownFromAPI(metrics[i].SystemMetrics.CpuBreakdown))
+					breakdownCount++
+				}
+
+				if metrics[i].ProcessMetrics != nil {
+					processSum += float64(metrics[i].ProcessMetrics.HostCpuPercent)
+					processCount++
+				}
+
+				if metrics[i].CgroupMetrics != nil {
+					throttledSum += float64(metrics[i].CgroupMetrics.ThrottledPeriodsPercent)
+					throttledCount++
+				}
 			}
 
 			if cpuCount > 0 {
 				steadyStateMean := cpuSum / float64(cpuCount)
 				hostMetrics[hostName] = append(hostMetrics[hostName], steadyStateMean)
 			}
+			if hottestCount > 0 {
+				hostHottest[hostName] = append(hostHottest[hostName], hottestSum/float64(hottestCount))
+			}
+			if processCount > 0 {
+				hostProcess[hostName] = append(hostProcess[hostName], processSum/float64(processCount))
+			}
+			if throttledCount > 0 {
+				hostThrottled[hostName] = append(hostThrottled[hostName], throttledSum/float64(throttledCount))
+			}
+			if breakdownCount > 0 {
+				hostBreakdown[hostName] = append(hostBreakdown[hostName], breakdownSum.scale(1/float64(breakdownCount)))
+			}
 		}
 	}
 
@@ -888,14 +924,27 @@ func (s *Service) calculateCPUStats(experiments []*ExperimentData) map[string]*C
 		// Calculate confidence interval returns SteadyStateStats, extract CPU fields
 		ci := calculateConfidenceInterval(cpuValues, 0.95)
 		cpuStats[hostName] = &CPUStats{
-			CPUMean:         ci.CPUMean,
-			CPUStdDev:       ci.CPUStdDev,
-			CPUConfLower:    ci.CPUConfLower,
-			CPUConfUpper:    ci.CPUConfUpper,
-			CPUMin:          ci.CPUMin,
-			CPUMax:          ci.CPUMax,
-			SampleSize:      ci.SampleSize,
-			ConfidenceLevel: ci.ConfidenceLevel,
+			CPUMean:              ci.CPUMean,
+			CPUStdDev:            ci.CPUStdDev,
+			CPUConfLower:         ci.CPUConfLower,
+			CPUConfUpper:         ci.CPUConfUpper,
+			CPUMin:               ci.CPUMin,
+			CPUMax:               ci.CPUMax,
+			SampleSize:           ci.SampleSize,
+			ConfidenceLevel:      ci.ConfidenceLevel,
+			HottestCoreMean:      average(hostHottest[hostName]),
+			ProcessCPUMean:       average(hostProcess[hostName]),
+			ThrottledPeriodsMean: average(hostThrottled[hostName]),
+		}
+
+		// Collectors that predate the breakdown leave it unset
+		if breakdowns := hostBreakdown[hostName]; len(breakdowns) > 0 {
+			var sum CPUBreakdown
+			for _, b := range breakdowns {
+				sum.add(b)
+			}
+			mean := sum.scale(1 / float64(len(breakdowns)))
+			cpuStats[hostName].Breakdown = &mean
 		}
 	}
 
@@ -913,6 +962,7 @@ func (s *Service) calculateLatencyStats(experiments []*ExperimentData) *LatencyS
 	var p50Values, p90Values, p95Values, p99Values []float64
 	var meanValues, minValues, maxValues []float64
 	var throughputs, errorRates, utilizations []float64
+	var cacheHitRatios []float64
 
 	for _, exp := range experiments {
 		if exp.RequesterResult != nil && exp.RequesterResult.Stats != nil {
@@ -947,6 +997,9 @@ func (s *Service) calculateLatencyStats(experiments []*ExperimentData) *LatencyS
 			if stats.Utilization > 0 {
 				utilizations = append(utilizations, float64(stats.Utilization))
 			}
+			if stats.CacheHits+stats.CacheMisses > 0 {
+				cacheHitRatios = append(cacheHitRatios, float64(stats.CacheHitRatio))
+			}
 		}
 	}
 
@@ -955,17 +1008,18 @@ func (s *Service) calculateLatencyStats(experiments []*ExperimentData) *LatencyS
 	}
 
 	latencyStats := &LatencyStats{
-		LatencyP50:  average(p50Values),
-		LatencyP90:  average(p90Values),
-		LatencyP95:  average(p95Values),
-		LatencyP99:  average(p99Values),
-		LatencyMean: average(meanValues),
-		LatencyMin:  min(minValues),
-		LatencyMax:  max(maxValues),
-		Throughput:  average(throughputs),
-		ErrorRate:   average(errorRates),
-		Utilization: average(utilizations),
-		SampleSize:  len(p50Values),
+		LatencyP50:    average(p50Values),
+		LatencyP90:    average(p90Values),
+		LatencyP95:    average(p95Values),
+		LatencyP99:    average(p99Values),
+		LatencyMean:   average(meanValues),
+		LatencyMin:    min(minValues),
+		LatencyMax:    max(maxValues),
+		Throughput:    average(throughputs),
+		ErrorRate:     average(errorRates),
+		Utilization:   average(utilizations),
+		SampleSize:    len(p50Values),
+		CacheHitRatio: average(cacheHitRatios),
 	}
 
 	s.logger.Info().Int("sample_size", latencyStats.SampleSize).Msg("Calculated latency statistics")
@@ -979,7 +1033,7 @@ func (s *Service) calculateSteadyStateStats(experiments []*ExperimentData) map[s
 		return nil
 	}
 
-	cpuStats := s.calculateCPUStats(experiments)
+	cpuStats := s.calculateCPUStats(experiments, false)
 	latencyStats := s.calculateLatencyStats(experiments)
 
 	// Merge into old format for backward compatibility
@@ -1014,6 +1068,33 @@ func (s *Service) calculateSteadyStateStats(experiments []*ExperimentData) map[s
 	return stats
 }
 
+// steadyStateStartIndex returns the index of the first steady-state data point. The first 10% of the
+// collection time is warm-up; going by timestamps rather than point count keeps the window the same
+// whatever the collection interval, and when ticks were dropped. The first point is always skipped
+// because it carries no rates yet.
+func steadyStateStartIndex(metrics []collectorAPI.MetricDataPoint) int {
+	if len(metrics) < 2 {
+		return 0
+	}
+
+	first, last := metrics[0].Timestamp, metrics[len(metrics)-1].Timestamp
+	if !last.After(first) {
+		// No usable timestamps, fall back to skipping the first 10% of the points
+		if start := len(metrics) / 10; start > 1 {
+			return start
+		}
+		return 1
+	}
+
+	warmupEnd := first.Add(last.Sub(first) / 10)
+	for i := 1; i < len(metrics); i++ {
+		if !metrics[i].Timestamp.Before(warmupEnd) {
+			return i
+		}
+	}
+	return len(metrics) - 1
+}
+
 // Helper functions for latency metrics
 func average(values []float64) float64 {
 	if len(values) == 0 {
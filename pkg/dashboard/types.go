@@ -139,21 +139,82 @@ type CPUStats struct {
 	CPUMax          float64 `json:"cpu_max"`          // Maximum value
 	SampleSize      int     `json:"sample_size"`      // Number of experiments used
 	ConfidenceLevel float64 `json:"confidence_level"` // Confidence level (e.g., 0.95)
+
+	// Per-core, calculator process and cgroup detail, omitted when the collector did not report it
+	HottestCoreMean      float64 `json:"hottest_core_mean,omitempty"`      // Mean steady-state usage of the busiest core
+	ProcessCPUMean       float64 `json:"process_cpu_mean,omitempty"`       // Mean steady-state host CPU usage of the calculator process
+	ThrottledPeriodsMean float64 `json:"throttled_periods_mean,omitempty"` // Mean steady-state share of throttled cgroup periods
+
+	// Where the CPU time went, only aggregated when ExperimentGroupConfig.CPUBreakdown is set
+	Breakdown *CPUBreakdown `json:"breakdown,omitempty"`
+}
+
+// CPUBreakdown is the mean steady-state share of CPU time spent in each state, in percent
+type CPUBreakdown struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Iowait  float64 `json:"iowait"`
+	Irq     float64 `json:"irq"`
+	Softirq float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
+	Idle    float64 `json:"idle"`
+}
+
+// cpuBreakdownFromAPI converts a collector data point breakdown
+func cpuBreakdownFromAPI(b *collectorAPI.CPUBreakdown) CPUBreakdown {
+	return CPUBreakdown{
+		User:    float64(b.User),
+		Nice:    float64(b.Nice),
+		System:  float64(b.System),
+		Iowait:  float64(b.Iowait),
+		Irq:     float64(b.Irq),
+		Softirq: float64(b.Softirq),
+		Steal:   float64(b.Steal),
+		Idle:    float64(b.Idle),
+	}
+}
+
+// add accumulates o into b
+func (b *CPUBreakdown) add(o CPUBreakdown) {
+	b.User += o.User
+	b.Nice += o.Nice
+	b.System += o.System
+	b.Iowait += o.Iowait
+	b.Irq += o.Irq
+	b.Softirq += o.Softirq
+	b.Steal += o.Steal
+	b.Idle += o.Idle
+}
+
+// scale multiplies every share by f, used to turn sums into means
+func (b CPUBreakdown) scale(f float64) CPUBreakdown {
+	return CPUBreakdown{
+		User:    b.User * f,
+		Nice:    b.Nice * f,
+		System:  b.System * f,
+		Iowait:  b.Iowait * f,
+		Irq:     b.Irq * f,
+		Softirq: b.Softirq * f,
+		Steal:   b.Steal * f,
+		Idle:    b.Idle * f,
+	}
 }
 
 // LatencyStats contains latency performance statistics from requester perspective
 type LatencyStats struct {
-	LatencyP50  float64 `json:"latency_p50"`  // Median latency in milliseconds
-	LatencyP90  float64 `json:"latency_p90"`  // 90th percentile latency
-	LatencyP95  float64 `json:"latency_p95"`  // 95th percentile latency
-	LatencyP99  float64 `json:"latency_p99"`  // 99th percentile latency
-	LatencyMean float64 `json:"latency_mean"` // Mean latency
-	LatencyMin  float64 `json:"latency_min"`  // Min latency
-	LatencyMax  float64 `json:"latency_max"`  // Max latency
-	Throughput  float64 `json:"throughput"`   // Successful requests per second
-	ErrorRate   float64 `json:"error_rate"`   // Error rate percentage
-	Utilization float64 `json:"utilization"`  // Server utilization (λ/μ)
-	SampleSize  int     `json:"sample_size"`  // Number of experiments used
+	LatencyP50    float64 `json:"latency_p50"`               // Median latency in milliseconds
+	LatencyP90    float64 `json:"latency_p90"`               // 90th percentile latency
+	LatencyP95    float64 `json:"latency_p95"`               // 95th percentile latency
+	LatencyP99    float64 `json:"latency_p99"`               // 99th percentile latency
+	LatencyMean   float64 `json:"latency_mean"`              // Mean latency
+	LatencyMin    float64 `json:"latency_min"`               // Min latency
+	LatencyMax    float64 `json:"latency_max"`               // Max latency
+	Throughput    float64 `json:"throughput"`                // Successful requests per second
+	ErrorRate     float64 `json:"error_rate"`                // Error rate percentage
+	Utilization   float64 `json:"utilization"`               // Server utilization (λ/μ)
+	SampleSize    int     `json:"sample_size"`               // Number of experiments used
+	CacheHitRatio float64 `json:"cache_hit_ratio,omitempty"` // Cache hit ratio reported by the target
 }
 
 // SteadyStateStats contains steady-state performance statistics with confidence intervals
@@ -185,12 +246,13 @@ type SteadyStateStats struct {
 
 // ExperimentGroupConfig defines the configuration for an experiment group
 type ExperimentGroupConfig struct {
-	QPSMin       int `json:"qps_min"`       // Minimum QPS value (e.g., 100)
-	QPSMax       int `json:"qps_max"`       // Maximum QPS value (e.g., 500)
-	QPSStep      int `json:"qps_step"`      // Step size for QPS values (e.g., 100)
-	RepeatCount  int `json:"repeat_count"`  // Number of times to repeat each QPS
-	Timeout      int `json:"timeout"`       // Timeout for each experiment in seconds
-	DelayBetween int `json:"delay_between"` // Delay between experiments in seconds
+	QPSMin       int  `json:"qps_min"`                 // Minimum QPS value (e.g., 100)
+	QPSMax       int  `json:"qps_max"`                 // Maximum QPS value (e.g., 500)
+	QPSStep      int  `json:"qps_step"`                // Step size for QPS values (e.g., 100)
+	RepeatCount  int  `json:"repeat_count"`            // Number of times to repeat each QPS
+	Timeout      int  `json:"timeout"`                 // Timeout for each experiment in seconds
+	DelayBetween int  `json:"delay_between"`           // Delay between experiments in seconds
+	CPUBreakdown bool `json:"cpu_breakdown,omitempty"` // Aggregate the user/system/iowait/... split into CPUStats
 }
 
 // Implement json.Marshaler and json.Unmarshaler for ExperimentGroup
@@ -16,12 +16,17 @@ type Data interface {
 
 type CollectFunc[T Data] func(context.Context, gin.Params) (T, error)
 
+// SavedFunc is called once the data of experiment id has been saved. ctx is the experiment context,
+// its error tells whether the experiment was stopped or timed out.
+type SavedFunc[T Data] func(ctx context.Context, id string, data T)
+
 type Experiment[T Data] struct {
 	ctx context.Context
 
 	logger zerolog.Logger
 
 	CollectData CollectFunc[T]
+	OnSaved     SavedFunc[T]
 
 	fs FileStorage[T]
 
@@ -41,6 +46,10 @@ func (s *Experiment[T]) SetDataCollector(f CollectFunc[T]) {
 	s.CollectData = f
 }
 
+func (s *Experiment[T]) SetOnSaved(f SavedFunc[T]) {
+	s.OnSaved = f
+}
+
 func (s *Experiment[T]) Start(id string, timeout time.Duration, params gin.Params) error {
 	if id == "" {
 		return fmt.Errorf("id must not be empty")
@@ -66,6 +75,10 @@ func (s *Experiment[T]) Start(id string, timeout time.Duration, params gin.Param
 		err = s.fs.Save(id, data)
 		if err != nil {
 			s.logger.Error().Err(err).Msg("failed to save data")
+			return
+		}
+		if s.OnSaved != nil {
+			s.OnSaved(ctx, id, data)
 		}
 	}()
 
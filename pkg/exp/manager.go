@@ -11,6 +11,7 @@ type Manager[T Data] struct {
 	logger zerolog.Logger
 
 	collector CollectFunc[T]
+	onSaved   SavedFunc[T]
 
 	fs FileStorage[T]
 
@@ -26,6 +27,11 @@ func NewManager[T Data](fs FileStorage[T], collector CollectFunc[T], logger zero
 	}
 }
 
+// SetOnSaved sets the function called after the data of each experiment has been saved
+func (f *Manager[T]) SetOnSaved(fn SavedFunc[T]) {
+	f.onSaved = fn
+}
+
 func (f *Manager[T]) Start(id string, timeout time.Duration, params gin.Params) error {
 	if f.currentExperiment != nil && !f.currentExperiment.IsDone() {
 		return fmt.Errorf("experiment already started")
@@ -33,6 +39,7 @@ func (f *Manager[T]) Start(id string, timeout time.Duration, params gin.Params)
 
 	exp := NewExperiment(f.fs, f.logger)
 	exp.SetDataCollector(f.collector)
+	exp.SetOnSaved(f.onSaved)
 
 	err := exp.Start(id, timeout, params)
 	if err != nil {
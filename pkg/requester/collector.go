@@ -3,21 +3,39 @@ package requester
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
 	"math/rand"
 	"net/http"
 	"sort"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	pb "cpusim/calculator/api/generated"
+	"cpusim/internal/servertiming"
+
+	"github.com/quic-go/quic-go/http3"
 )
 
+// requestTimeout bounds every request, for both HTTP and gRPC
+const requestTimeout = 5 * time.Second
+
+// numWorkers is the number of goroutines sending requests, each with its own share of the QPS
+const numWorkers = 16
+
 // Collector handles sending HTTP requests and collecting statistics
 type Collector struct {
 	config     Config
 	httpClient *http.Client
+	scheme     string // "http", or "https" for HTTP/3
+
+	// closeTransport releases the transport's connections (and the UDP socket for HTTP/3)
+	closeTransport func()
 
 	// Statistics
 	totalRequests atomic.Int64  // Total requests actually sent
@@ -32,30 +50,30 @@ type Collector struct {
 	workerResponseTimes [][]float64
 	workerSamples       [][]ResponseTimeSnapshot
 	maxSamples          int
+
+	// Per-worker server-side timings from the Server-Timing header, in milliseconds
+	workerServiceTimes [][]float64
+	workerQueueTimes   [][]float64
+
+	// Cache outcomes reported in the Server-Timing header
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
 }
 
 // NewCollector creates a new request collector
 func NewCollector(config Config) *Collector {
-	numWorkers := 16
-
-	// Configure HTTP transport for connection pooling with keep-alive
-	// Uses persistent connections to reduce connection overhead
-	transport := &http.Transport{
-		MaxIdleConns:        200,  // Maximum idle connections across all hosts
-		MaxIdleConnsPerHost: 100,  // Maximum idle connections per host
-		MaxConnsPerHost:     200,  // Maximum connections per host (including active)
-		IdleConnTimeout:     90 * time.Second, // Keep idle connections alive
-		DisableKeepAlives:   false, // Enable HTTP keep-alive for connection reuse
-	}
+	transport, scheme, closeTransport := newTransport(config.Protocol)
 
 	httpClient := &http.Client{
 		Transport: transport,
-		Timeout:   5 * time.Second,
+		Timeout:   requestTimeout,
 	}
 
 	// Pre-allocate per-worker slices to avoid lock contention
 	workerResponseTimes := make([][]float64, numWorkers)
 	workerSamples := make([][]ResponseTimeSnapshot, numWorkers)
+	workerServiceTimes := make([][]float64, numWorkers)
+	workerQueueTimes := make([][]float64, numWorkers)
 	for i := 0; i < numWorkers; i++ {
 		workerResponseTimes[i] = make([]float64, 0, 10000/numWorkers)
 		workerSamples[i] = make([]ResponseTimeSnapshot, 0, 1000/numWorkers)
@@ -64,12 +82,46 @@ func NewCollector(config Config) *Collector {
 	return &Collector{
 		config:              config,
 		httpClient:          httpClient,
+		scheme:              scheme,
+		closeTransport:      closeTransport,
 		workerResponseTimes: workerResponseTimes,
 		workerSamples:       workerSamples,
 		maxSamples:          1000,
+		workerServiceTimes:  workerServiceTimes,
+		workerQueueTimes:    workerQueueTimes,
 	}
 }
 
+// newTransport creates the HTTP transport for protocol and returns the URL scheme to use
+// together with a function that releases its connections
+func newTransport(protocol Protocol) (http.RoundTripper, string, func()) {
+	if protocol == ProtocolHTTP3 {
+		// cpusim-server generates a self-signed certificate unless one is configured
+		transport := &http3.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		return transport, "https", func() { _ = transport.Close() }
+	}
+
+	// Configure HTTP transport for connection pooling with keep-alive
+	// Uses persistent connections to reduce connection overhead
+	transport := &http.Transport{
+		MaxIdleConns:        200,  // Maximum idle connections across all hosts
+		MaxIdleConnsPerHost: 100,  // Maximum idle connections per host
+		MaxConnsPerHost:     200,  // Maximum connections per host (including active)
+		IdleConnTimeout:     90 * time.Second, // Keep idle connections alive
+		DisableKeepAlives:   false, // Enable HTTP keep-alive for connection reuse
+	}
+
+	if protocol == ProtocolH2C {
+		// Cleartext HTTP/2 with prior knowledge; requests are multiplexed as streams
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetUnencryptedHTTP2(true)
+	}
+
+	return transport, "http", transport.CloseIdleConnections
+}
+
 // workerStats holds statistics for a single worker
 type workerStats struct {
 	startTime time.Time
@@ -85,14 +137,18 @@ func (c *Collector) Run(ctx context.Context) (*RequestData, error) {
 		qps = 1
 	}
 
-	targetURL := fmt.Sprintf("http://%s:%d/calculate", c.config.TargetIP, c.config.TargetPort)
+	defer c.closeTransport()
+
+	send, closeSend, err := c.newSender()
+	if err != nil {
+		return nil, err
+	}
+	defer closeSend()
 
 	// Use WaitGroup to track worker goroutines
 	var wg sync.WaitGroup
 
-	// Create 16 parallel worker goroutines for better performance
-	numWorkers := 16
-
+	// Spread the load over numWorkers parallel worker goroutines for better performance
 	// Channel to collect worker statistics
 	statsChan := make(chan workerStats, numWorkers)
 
@@ -119,7 +175,7 @@ func (c *Collector) Run(ctx context.Context) (*RequestData, error) {
 					return
 				case <-queue:
 					// Send request synchronously in this dedicated goroutine
-					c.sendRequest(ctx, targetURL, workerID)
+					send(ctx, workerID)
 				}
 			}
 		}(i)
@@ -304,12 +360,93 @@ func (c *Collector) Run(ctx context.Context) (*RequestData, error) {
 	return c.buildResultData(overallStart, overallEnd, totalQPS), nil
 }
 
+// newSender returns the function the workers use to send one request over the configured
+// protocol, together with a function that releases its resources
+func (c *Collector) newSender() (func(ctx context.Context, workerID int), func(), error) {
+	if c.config.Protocol == ProtocolGRPC {
+		pool, err := newGRPCPool(c.config.TargetIP, c.config.TargetPort, c.config.GRPCPoolSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys := c.newKeyGenerators()
+		send := func(ctx context.Context, workerID int) {
+			req := &pb.CalculateRequest{
+				Kind:       c.config.Workload,
+				Size:       int32(c.config.WorkSize),
+				Iterations: int32(c.config.Iterations),
+				Key:        keys[workerID](),
+			}
+			c.sendGRPCRequest(ctx, pool.client(workerID), req, workerID)
+		}
+		return send, pool.close, nil
+	}
+
+	targetURL := fmt.Sprintf("%s://%s:%d/calculate", c.scheme, c.config.TargetIP, c.config.TargetPort)
+
+	// Build the request body once, it is identical for every request unless cache keys are sent
+	reqBody := calculationRequest{
+		Kind:       c.config.Workload,
+		Size:       c.config.WorkSize,
+		Iterations: c.config.Iterations,
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode request body: %w", err)
+	}
+
+	if c.config.CacheKeys > 0 {
+		keys := c.newKeyGenerators()
+		send := func(ctx context.Context, workerID int) {
+			keyed := reqBody
+			keyed.Key = keys[workerID]()
+			body, _ := json.Marshal(keyed)
+			c.sendRequest(ctx, targetURL, body, workerID)
+		}
+		return send, func() {}, nil
+	}
+
+	send := func(ctx context.Context, workerID int) {
+		c.sendRequest(ctx, targetURL, body, workerID)
+	}
+	return send, func() {}, nil
+}
+
+// newKeyGenerators returns one cache key generator per worker (each with its own random source,
+// so no locking is needed). Keys are drawn from CacheKeys distinct values, uniformly or with a
+// Zipf popularity; every generator returns "" when CacheKeys is 0.
+func (c *Collector) newKeyGenerators() []func() string {
+	gens := make([]func() string, len(c.workerResponseTimes))
+	for i := range gens {
+		n := c.config.CacheKeys
+		switch {
+		case n <= 0:
+			gens[i] = func() string { return "" }
+		case c.config.CacheKeyZipf > 1:
+			rng := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+			zipf := rand.NewZipf(rng, c.config.CacheKeyZipf, 1, uint64(n-1))
+			gens[i] = func() string { return "k" + strconv.FormatUint(zipf.Uint64(), 10) }
+		default:
+			rng := rand.New(rand.NewSource(time.Now().UnixNano() + int64(i)))
+			gens[i] = func() string { return "k" + strconv.Itoa(rng.Intn(n)) }
+		}
+	}
+	return gens
+}
+
+// calculationRequest is the JSON body sent to cpusim-server's /calculate endpoint
+type calculationRequest struct {
+	Kind       string `json:"kind,omitempty"`
+	Size       int    `json:"size,omitempty"`
+	Iterations int    `json:"iterations,omitempty"`
+	Key        string `json:"key,omitempty"`
+}
+
 // sendRequest sends a single HTTP request and records statistics
-func (c *Collector) sendRequest(ctx context.Context, targetURL string, workerID int) {
+func (c *Collector) sendRequest(ctx context.Context, targetURL string, body []byte, workerID int) {
 	startTime := time.Now()
 
-	// Create request with empty JSON body
-	req, err := http.NewRequestWithContext(ctx, "POST", targetURL, bytes.NewBufferString("{}"))
+	// Create request with the pre-encoded JSON body ("{}" when using server defaults)
+	req, err := http.NewRequestWithContext(ctx, "POST", targetURL, bytes.NewReader(body))
 	if err != nil {
 		c.recordFailure(startTime, err, workerID)
 		return
@@ -333,14 +470,38 @@ func (c *Collector) sendRequest(ctx context.Context, targetURL string, workerID
 
 	// Check status code
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		c.recordSuccess(startTime, responseTime, workerID)
+		timing := parseServerTiming(resp.Header.Get("Server-Timing"))
+		c.recordSuccess(startTime, responseTime, timing, workerID)
 	} else {
 		c.recordFailure(startTime, fmt.Errorf("HTTP %d", resp.StatusCode), workerID)
 	}
 }
 
+// serverTiming is the server-side breakdown reported in the Server-Timing response header
+type serverTiming struct {
+	queueMs   float64
+	computeMs float64
+	present   bool
+	cache     string // "hit" or "miss" from the cache metric's desc, empty when absent
+}
+
+// parseServerTiming extracts the "queue" and "compute" durations (milliseconds) and the "cache"
+// description from a Server-Timing header such as "queue;dur=0.120, compute;dur=4.512, cache;desc=hit".
+// Unknown metrics and parameters are ignored.
+func parseServerTiming(header string) serverTiming {
+	metrics := servertiming.Parse(header)
+	compute := metrics["compute"]
+	return serverTiming{
+		queueMs:   metrics["queue"].Dur,
+		computeMs: compute.Dur,
+		// Only the compute metric marks the header as usable for service time stats
+		present: compute.HasDur,
+		cache:   metrics["cache"].Desc,
+	}
+}
+
 // recordSuccess records a successful request (lock-free per-worker collection)
-func (c *Collector) recordSuccess(timestamp time.Time, responseTime time.Duration, workerID int) {
+func (c *Collector) recordSuccess(timestamp time.Time, responseTime time.Duration, timing serverTiming, workerID int) {
 	c.totalRequests.Add(1)
 	c.successful.Add(1)
 
@@ -349,12 +510,25 @@ func (c *Collector) recordSuccess(timestamp time.Time, responseTime time.Duratio
 	// Store response time in worker-specific slice (no lock needed)
 	c.workerResponseTimes[workerID] = append(c.workerResponseTimes[workerID], rtMs)
 
+	if timing.present {
+		c.workerServiceTimes[workerID] = append(c.workerServiceTimes[workerID], timing.computeMs)
+		c.workerQueueTimes[workerID] = append(c.workerQueueTimes[workerID], timing.queueMs)
+	}
+	switch timing.cache {
+	case "hit":
+		c.cacheHits.Add(1)
+	case "miss":
+		c.cacheMisses.Add(1)
+	}
+
 	// Store sample in worker-specific slice (limited, no lock needed)
 	if len(c.workerSamples[workerID]) < c.maxSamples/16 {
 		c.workerSamples[workerID] = append(c.workerSamples[workerID], ResponseTimeSnapshot{
 			Timestamp:    timestamp,
 			ResponseTime: rtMs,
 			Success:      true,
+			ServiceTime:  timing.computeMs,
+			QueueTime:    timing.queueMs,
 		})
 	}
 }
@@ -454,6 +628,16 @@ func (c *Collector) calculateStats(duration float64, totalReqs, failed int64, ac
 	// Calculate latency buckets (histogram)
 	stats.LatencyBuckets = c.calculateLatencyBuckets(allResponseTimes)
 
+	// Server-side service and queueing times, when the target reports them
+	c.calculateServerTimingStats(&stats)
+
+	// Cache hit ratio, when the target's cache emulation is on
+	stats.CacheHits = c.cacheHits.Load()
+	stats.CacheMisses = c.cacheMisses.Load()
+	if total := stats.CacheHits + stats.CacheMisses; total > 0 {
+		stats.CacheHitRatio = float64(stats.CacheHits) / float64(total)
+	}
+
 	// Add Poisson arrival metrics if in Poisson mode
 	generated := c.generatedRequests.Load()
 	dropped := c.droppedRequests.Load()
@@ -478,11 +662,14 @@ func (c *Collector) calculateStats(duration float64, totalReqs, failed int64, ac
 		if generated > 0 && stats.TargetArrivalRate > 0 {
 			arrivalRate = stats.TargetArrivalRate
 		}
-		// μ (mu) = 1 / average response time (service rate)
-		// Average response time in seconds
-		avgResponseTimeSec := stats.AvgResponseTime / 1000.0
-		if avgResponseTimeSec > 0 {
-			serviceRate := 1.0 / avgResponseTimeSec // μ
+		// μ (mu) = 1 / average service time. Prefer the server-reported compute time,
+		// which excludes network and queueing delay; fall back to the response time.
+		avgServiceTimeSec := stats.AvgResponseTime / 1000.0
+		if stats.AvgServiceTime > 0 {
+			avgServiceTimeSec = stats.AvgServiceTime / 1000.0
+		}
+		if avgServiceTimeSec > 0 {
+			serviceRate := 1.0 / avgServiceTimeSec // μ
 			stats.Utilization = arrivalRate / serviceRate
 		}
 	}
@@ -490,6 +677,48 @@ func (c *Collector) calculateStats(duration float64, totalReqs, failed int64, ac
 	return stats
 }
 
+// calculateServerTimingStats fills the service and queue time statistics from the
+// per-worker Server-Timing samples
+func (c *Collector) calculateServerTimingStats(stats *RequestStats) {
+	var serviceTimes, queueTimes []float64
+	for i := range c.workerServiceTimes {
+		serviceTimes = append(serviceTimes, c.workerServiceTimes[i]...)
+		queueTimes = append(queueTimes, c.workerQueueTimes[i]...)
+	}
+	if len(serviceTimes) == 0 {
+		return
+	}
+
+	sort.Float64s(serviceTimes)
+	sort.Float64s(queueTimes)
+
+	stats.ServerTimingSamples = int64(len(serviceTimes))
+	stats.AvgServiceTime = mean(serviceTimes)
+	stats.ServiceTimeP50 = percentile(serviceTimes, 0.5)
+	stats.ServiceTimeP95 = percentile(serviceTimes, 0.95)
+	stats.ServiceTimeP99 = percentile(serviceTimes, 0.99)
+	if stats.AvgServiceTime > 0 {
+		stats.ServiceRate = 1000.0 / stats.AvgServiceTime
+	}
+
+	stats.AvgQueueTime = mean(queueTimes)
+	stats.QueueTimeP50 = percentile(queueTimes, 0.5)
+	stats.QueueTimeP90 = percentile(queueTimes, 0.90)
+	stats.QueueTimeP99 = percentile(queueTimes, 0.99)
+}
+
+// mean returns the arithmetic mean of values
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
 // percentile calculates the percentile value from a sorted slice
 func percentile(sorted []float64, p float64) float64 {
 	if len(sorted) == 0 {
@@ -0,0 +1,194 @@
+package requester
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "cpusim/calculator/api/generated"
+
+	"github.com/quic-go/quic-go/http3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestParseServerTiming(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header string
+		want   serverTiming
+	}{
+		{"empty header", "", serverTiming{}},
+		{"queue and compute", "queue;dur=0.120, compute;dur=4.512",
+			serverTiming{queueMs: 0.12, computeMs: 4.512, present: true}},
+		{"compute only", "compute;dur=3", serverTiming{computeMs: 3, present: true}},
+		{"queue only is not usable", "queue;dur=2", serverTiming{queueMs: 2}},
+		{"missing dur", "queue, compute;desc=\"no duration\"", serverTiming{}},
+		{"quoted values", `queue;dur="1.5", compute;dur="2.5", cache;desc="hit"`,
+			serverTiming{queueMs: 1.5, computeMs: 2.5, present: true, cache: "hit"}},
+		{"multiple metrics", "db;dur=9, queue;dur=1, downstream;dur=7, compute;dur=2, cache;desc=miss",
+			serverTiming{queueMs: 1, computeMs: 2, present: true, cache: "miss"}},
+		{"extra params and spaces", " compute ; desc=calc ; dur = 5 ", serverTiming{computeMs: 5, present: true}},
+		{"invalid and negative dur", "queue;dur=abc, compute;dur=-1", serverTiming{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := parseServerTiming(tc.header); got != tc.want {
+				t.Errorf("parseServerTiming(%q) = %+v, want %+v", tc.header, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServerTimingStats(t *testing.T) {
+	// Responses alternate between full timing, a cache hit and no Server-Timing at all
+	headers := []string{
+		"queue;dur=10, compute;dur=20, cache;desc=miss",
+		"queue;dur=30, compute;dur=40, cache;desc=hit",
+		"",
+	}
+	var n atomic.Int64
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h := headers[(n.Add(1)-1)%int64(len(headers))]; h != "" {
+			w.Header().Set("Server-Timing", h)
+		}
+	}))
+	defer target.Close()
+
+	c := NewCollector(Config{})
+	for range 6 {
+		c.sendRequest(context.Background(), target.URL, []byte("{}"), 0)
+	}
+	now := time.Now()
+	stats := c.buildResultData(now.Add(-time.Second), now, 6).Stats
+
+	// Only responses with a compute metric count towards service and queue times
+	if stats.ServerTimingSamples != 4 {
+		t.Errorf("ServerTimingSamples = %d, want 4", stats.ServerTimingSamples)
+	}
+	if stats.AvgServiceTime != 30 || stats.AvgQueueTime != 20 {
+		t.Errorf("AvgServiceTime = %v, AvgQueueTime = %v, want 30 and 20", stats.AvgServiceTime, stats.AvgQueueTime)
+	}
+	if stats.ServiceRate != 1000.0/30 {
+		t.Errorf("ServiceRate = %v, want %v (1/E[compute])", stats.ServiceRate, 1000.0/30)
+	}
+	if stats.CacheHits != 2 || stats.CacheMisses != 2 || stats.CacheHitRatio != 0.5 {
+		t.Errorf("cache hits %d, misses %d, ratio %v, want 2, 2, 0.5", stats.CacheHits, stats.CacheMisses, stats.CacheHitRatio)
+	}
+
+	// Sampled responses carry the server-side split, zero when the header was absent
+	var withTiming int
+	for _, s := range c.workerSamples[0] {
+		if s.ServiceTime > 0 {
+			withTiming++
+			if s.QueueTime != s.ServiceTime-10 {
+				t.Errorf("sample queue time = %v with service time %v, want %v", s.QueueTime, s.ServiceTime, s.ServiceTime-10)
+			}
+		}
+	}
+	if withTiming != 4 {
+		t.Errorf("%d samples with server timing, want 4", withTiming)
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	target := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Proto", r.Proto)
+	}))
+	target.Config.Protocols = new(http.Protocols)
+	target.Config.Protocols.SetHTTP1(true)
+	target.Config.Protocols.SetUnencryptedHTTP2(true)
+	target.Start()
+	defer target.Close()
+
+	for _, tc := range []struct {
+		protocol Protocol
+		want     string
+	}{
+		{ProtocolHTTP1, "HTTP/1.1"},
+		{ProtocolH2C, "HTTP/2.0"},
+	} {
+		transport, scheme, closeTransport := newTransport(tc.protocol)
+		if scheme != "http" {
+			t.Errorf("%s: scheme = %s, want http", tc.protocol, scheme)
+		}
+		resp, err := (&http.Client{Transport: transport}).Get(target.URL)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.protocol, err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("X-Proto"); got != tc.want {
+			t.Errorf("%s: server saw %s, want %s", tc.protocol, got, tc.want)
+		}
+		closeTransport()
+	}
+
+	// HTTP/3 always runs over TLS, accepting the server's self-signed certificate
+	transport, scheme, closeTransport := newTransport(ProtocolHTTP3)
+	defer closeTransport()
+	h3, ok := transport.(*http3.Transport)
+	if !ok {
+		t.Fatalf("h3 transport is %T, want *http3.Transport", transport)
+	}
+	if scheme != "https" || h3.TLSClientConfig == nil || !h3.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("h3: scheme %s, TLS config %+v, want https skipping verification", scheme, h3.TLSClientConfig)
+	}
+}
+
+// fakeCalculator answers every call with a fixed Server-Timing trailer, like cpusim-server does
+type fakeCalculator struct {
+	pb.UnimplementedCalculatorServer
+	timing string
+}
+
+func (f *fakeCalculator) Calculate(ctx context.Context, req *pb.CalculateRequest) (*pb.CalculateResponse, error) {
+	grpc.SetTrailer(ctx, metadata.Pairs("server-timing", f.timing))
+	return &pb.CalculateResponse{Kind: req.GetKind(), Result: "1"}, nil
+}
+
+func TestGRPCServerTimingMatchesHTTP(t *testing.T) {
+	const timing = "queue;dur=1.5, compute;dur=2.5, cache;desc=hit"
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterCalculatorServer(srv, &fakeCalculator{timing: timing})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server-Timing", timing)
+	}))
+	defer target.Close()
+
+	// The same timing reaches the stats identically over both transports
+	grpcCollector, httpCollector := NewCollector(Config{}), NewCollector(Config{})
+	for range 3 {
+		grpcCollector.sendGRPCRequest(context.Background(), pb.NewCalculatorClient(conn), &pb.CalculateRequest{}, 0)
+		httpCollector.sendRequest(context.Background(), target.URL, []byte("{}"), 0)
+	}
+	now := time.Now()
+	grpcStats := grpcCollector.buildResultData(now.Add(-time.Second), now, 3).Stats
+	httpStats := httpCollector.buildResultData(now.Add(-time.Second), now, 3).Stats
+
+	if grpcStats.ServerTimingSamples != 3 || grpcStats.AvgServiceTime != 2.5 || grpcStats.AvgQueueTime != 1.5 || grpcStats.CacheHits != 3 {
+		t.Errorf("gRPC stats: %d samples, service %v, queue %v, %d hits, want 3, 2.5, 1.5, 3",
+			grpcStats.ServerTimingSamples, grpcStats.AvgServiceTime, grpcStats.AvgQueueTime, grpcStats.CacheHits)
+	}
+	if grpcStats.AvgServiceTime != httpStats.AvgServiceTime || grpcStats.AvgQueueTime != httpStats.AvgQueueTime ||
+		grpcStats.CacheHitRatio != httpStats.CacheHitRatio {
+		t.Errorf("gRPC stats %+v differ from HTTP stats %+v", grpcStats, httpStats)
+	}
+}
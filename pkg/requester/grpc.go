@@ -0,0 +1,81 @@
+package requester
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "cpusim/calculator/api/generated"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcPool is a fixed set of gRPC connections to the target. Each connection is one HTTP/2
+// connection multiplexing many concurrent calls, so the pool size controls how the load is
+// spread over TCP connections (and server-side accept/read goroutines).
+type grpcPool struct {
+	conns   []*grpc.ClientConn
+	clients []pb.CalculatorClient
+}
+
+// newGRPCPool creates size connections to the target, size <= 0 means 1.
+// Connections are established lazily on the first call.
+func newGRPCPool(host string, port, size int) (*grpcPool, error) {
+	if size <= 0 {
+		size = 1
+	}
+
+	target := net.JoinHostPort(host, strconv.Itoa(port))
+	p := &grpcPool{}
+	for i := 0; i < size; i++ {
+		// Every ClientConn owns its own HTTP/2 connection, even for the same target
+		conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			p.close()
+			return nil, fmt.Errorf("failed to create gRPC connection to %s: %w", target, err)
+		}
+		p.conns = append(p.conns, conn)
+		p.clients = append(p.clients, pb.NewCalculatorClient(conn))
+	}
+	return p, nil
+}
+
+// client returns the connection used by a worker, workers are spread round-robin over the pool
+func (p *grpcPool) client(workerID int) pb.CalculatorClient {
+	return p.clients[workerID%len(p.clients)]
+}
+
+// close closes every connection in the pool
+func (p *grpcPool) close() {
+	for _, conn := range p.conns {
+		_ = conn.Close()
+	}
+}
+
+// sendGRPCRequest sends a single unary Calculate call and records statistics
+func (c *Collector) sendGRPCRequest(ctx context.Context, client pb.CalculatorClient, req *pb.CalculateRequest, workerID int) {
+	startTime := time.Now()
+
+	// Same per-request timeout as the HTTP client
+	callCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var trailer metadata.MD
+	_, err := client.Calculate(callCtx, req, grpc.Trailer(&trailer))
+	responseTime := time.Since(startTime)
+
+	if err != nil {
+		c.recordFailure(startTime, fmt.Errorf("gRPC %s", status.Code(err)), workerID)
+		return
+	}
+
+	// cpusim-server sends the Server-Timing header value as a trailer
+	timing := parseServerTiming(strings.Join(trailer.Get("server-timing"), ","))
+	c.recordSuccess(startTime, responseTime, timing, workerID)
+}
@@ -21,6 +21,23 @@ type Service struct {
 
 // NewService creates a new requester service
 func NewService(storagePath string, config Config, logger zerolog.Logger) (*Service, error) {
+	switch config.Protocol {
+	case "":
+		config.Protocol = ProtocolHTTP1
+	case ProtocolHTTP1, ProtocolH2C, ProtocolHTTP3, ProtocolGRPC:
+	default:
+		return nil, fmt.Errorf("unknown protocol: %s (supported: h1, h2c, h3, grpc)", config.Protocol)
+	}
+	if config.GRPCPoolSize < 0 || config.GRPCPoolSize > numWorkers {
+		return nil, fmt.Errorf("grpc pool size must be in [0, %d] (one connection per worker at most), got %d", numWorkers, config.GRPCPoolSize)
+	}
+	if config.CacheKeys < 0 {
+		return nil, fmt.Errorf("cache keys must not be negative, got %d", config.CacheKeys)
+	}
+	if config.CacheKeyZipf != 0 && config.CacheKeyZipf <= 1 {
+		return nil, fmt.Errorf("cache key zipf exponent must be greater than 1, got %v", config.CacheKeyZipf)
+	}
+
 	fs, err := exp.NewFileStorage[*RequestData](storagePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create file storage: %w", err)
@@ -47,6 +64,7 @@ func NewService(storagePath string, config Config, logger zerolog.Logger) (*Serv
 		s.logger.Info().
 			Str("target", fmt.Sprintf("%s:%d", s.config.TargetIP, s.config.TargetPort)).
 			Int("qps", qps).
+			Str("protocol", string(s.config.Protocol)).
 			Msg("Starting request experiment")
 
 		// Create a new config with the runtime QPS
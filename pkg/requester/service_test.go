@@ -203,3 +203,46 @@ func TestService_MultipleExperimentsSerial(t *testing.T) {
 	t.Logf("Experiment 1: %d requests", data1.TotalRequests)
 	t.Logf("Experiment 2: %d requests", data2.TotalRequests)
 }
+
+func TestNewService_Protocol(t *testing.T) {
+	logger := zerolog.Nop()
+	for _, tc := range []struct {
+		protocol Protocol
+		want     Protocol
+		ok       bool
+	}{
+		{"", ProtocolHTTP1, true},
+		{ProtocolH2C, ProtocolH2C, true},
+		{ProtocolHTTP3, ProtocolHTTP3, true},
+		{ProtocolGRPC, ProtocolGRPC, true},
+		{"h2", "", false},
+		{"HTTP/3", "", false},
+	} {
+		service, err := NewService(t.TempDir(), Config{TargetIP: "localhost", TargetPort: 80, QPS: 1, Protocol: tc.protocol}, logger)
+		if !tc.ok {
+			if err == nil {
+				t.Errorf("NewService accepted protocol %q", tc.protocol)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("NewService(protocol %q): %v", tc.protocol, err)
+		}
+		if service.config.Protocol != tc.want {
+			t.Errorf("protocol %q resolved to %q, want %q", tc.protocol, service.config.Protocol, tc.want)
+		}
+	}
+}
+
+func TestNewService_GRPCPoolSize(t *testing.T) {
+	logger := zerolog.Nop()
+	for _, tc := range []struct {
+		size int
+		ok   bool
+	}{{0, true}, {1, true}, {numWorkers, true}, {numWorkers + 1, false}, {-1, false}} {
+		_, err := NewService(t.TempDir(), Config{TargetIP: "localhost", TargetPort: 80, QPS: 1, Protocol: ProtocolGRPC, GRPCPoolSize: tc.size}, logger)
+		if (err == nil) != tc.ok {
+			t.Errorf("NewService(grpc pool size %d) = %v, want ok %v", tc.size, err, tc.ok)
+		}
+	}
+}
@@ -15,6 +15,20 @@ const (
 	ArrivalPatternPoisson ArrivalPattern = "poisson"
 )
 
+// Protocol represents the protocol used to reach the target
+type Protocol string
+
+const (
+	// ProtocolHTTP1 uses HTTP/1.1 with a pool of keep-alive connections
+	ProtocolHTTP1 Protocol = "h1"
+	// ProtocolH2C uses cleartext HTTP/2 with prior knowledge, multiplexing requests over few connections
+	ProtocolH2C Protocol = "h2c"
+	// ProtocolHTTP3 uses HTTP/3 over QUIC (the target certificate is not verified)
+	ProtocolHTTP3 Protocol = "h3"
+	// ProtocolGRPC calls the gRPC Calculate service (TargetPort is the server's -grpc-port)
+	ProtocolGRPC Protocol = "grpc"
+)
+
 // Config represents the configuration for a request experiment
 type Config struct {
 	TargetIP       string         `json:"target_ip"`
@@ -22,6 +36,21 @@ type Config struct {
 	QPS            int            `json:"qps"`
 	Timeout        int            `json:"timeout"`         // in seconds
 	ArrivalPattern ArrivalPattern `json:"arrival_pattern"` // "uniform" or "poisson", defaults to "uniform"
+	Protocol       Protocol       `json:"protocol"`        // "h1", "h2c", "h3" or "grpc", defaults to "h1"
+
+	// Workload selection sent in the /calculate request body (zero values use the server defaults)
+	Workload   string `json:"workload,omitempty"`   // workload kind, e.g. "gcd", "stream"
+	WorkSize   int    `json:"work_size,omitempty"`  // workload size (digits for gcd)
+	Iterations int    `json:"iterations,omitempty"` // iterations per request
+
+	// GRPCPoolSize is the number of gRPC connections shared round-robin by the workers
+	// (only used with ProtocolGRPC, 0 means 1). Every worker uses one connection, so it is at most
+	// the 16 sending workers.
+	GRPCPoolSize int `json:"grpc_pool_size,omitempty"`
+
+	// Cache keys sent with every request for the server's keyed LRU (-cache-size)
+	CacheKeys    int     `json:"cache_keys,omitempty"`     // number of distinct keys, 0 sends no key
+	CacheKeyZipf float64 `json:"cache_key_zipf,omitempty"` // Zipf exponent s > 1 for skewed key popularity, 0 means uniform
 }
 
 // RequestData represents the collected data from a request experiment
@@ -58,7 +87,24 @@ type RequestStats struct {
 	// Queueing theory metrics
 	LatencyBuckets map[string]int64 `json:"latency_buckets"` // histogram buckets for latency distribution
 	Throughput     float64          `json:"throughput"`      // successful requests per second
-	Utilization    float64          `json:"utilization"`     // server utilization (λ/μ)
+	Utilization    float64          `json:"utilization"`     // offered load λ·E[S], per-server utilization only for a single worker
+
+	// Server-side timing parsed from the Server-Timing header (only populated when the target sends it)
+	ServerTimingSamples int64   `json:"server_timing_samples,omitempty"` // responses that carried Server-Timing
+	AvgServiceTime      float64 `json:"avg_service_time,omitempty"`      // compute time in milliseconds, excluding queueing
+	ServiceTimeP50      float64 `json:"service_time_p50,omitempty"`
+	ServiceTimeP95      float64 `json:"service_time_p95,omitempty"`
+	ServiceTimeP99      float64 `json:"service_time_p99,omitempty"`
+	ServiceRate         float64 `json:"service_rate,omitempty"`   // μ = 1/E[S], requests per second per worker
+	AvgQueueTime        float64 `json:"avg_queue_time,omitempty"` // time queued in the server worker pool, in milliseconds
+	QueueTimeP50        float64 `json:"queue_time_p50,omitempty"`
+	QueueTimeP90        float64 `json:"queue_time_p90,omitempty"`
+	QueueTimeP99        float64 `json:"queue_time_p99,omitempty"`
+
+	// Cache outcomes reported by the target in Server-Timing (only populated when its cache emulation is on)
+	CacheHits     int64   `json:"cache_hits,omitempty"`
+	CacheMisses   int64   `json:"cache_misses,omitempty"`
+	CacheHitRatio float64 `json:"cache_hit_ratio,omitempty"` // hits / (hits + misses)
 }
 
 // ResponseTimeSnapshot represents a sample of response time at a specific time
@@ -66,6 +112,8 @@ type ResponseTimeSnapshot struct {
 	Timestamp    time.Time `json:"timestamp"`
 	ResponseTime float64   `json:"response_time"` // in milliseconds
 	Success      bool      `json:"success"`
+	ServiceTime  float64   `json:"service_time,omitempty"` // server compute time in milliseconds, from Server-Timing
+	QueueTime    float64   `json:"queue_time,omitempty"`   // server queueing time in milliseconds, from Server-Timing
 }
 
 // MarshalJSON implements json.Marshaler interface for RequestData
@@ -131,9 +131,24 @@ type RequestExperimentListResponse struct {
 
 // RequestExperimentStats defines model for RequestExperimentStats.
 type RequestExperimentStats struct {
+	// AverageQueueTime 服务端平均排队时间（毫秒，来自 Server-Timing）
+	AverageQueueTime float32 `json:"averageQueueTime,omitempty"`
+
 	// AverageResponseTime 平均响应时间（毫秒）
 	AverageResponseTime float32 `json:"averageResponseTime,omitempty"`
 
+	// AverageServiceTime 服务端平均计算时间（毫秒，来自 Server-Timing，不含排队）
+	AverageServiceTime float32 `json:"averageServiceTime,omitempty"`
+
+	// CacheHitRatio 缓存命中率 hits/(hits+misses)
+	CacheHitRatio float32 `json:"cacheHitRatio,omitempty"`
+
+	// CacheHits 目标服务报告的缓存命中数（未开启缓存仿真时为0）
+	CacheHits int64 `json:"cacheHits,omitempty"`
+
+	// CacheMisses 目标服务报告的缓存未命中数
+	CacheMisses int64 `json:"cacheMisses,omitempty"`
+
 	// Duration 持续时间（秒）
 	Duration int `json:"duration,omitempty"`
 
@@ -158,6 +173,15 @@ type RequestExperimentStats struct {
 	// MinResponseTime 最小响应时间（毫秒）
 	MinResponseTime float32 `json:"minResponseTime,omitempty"`
 
+	// QueueTimeP50 50%分位服务端排队时间（毫秒）
+	QueueTimeP50 float32 `json:"queueTimeP50,omitempty"`
+
+	// QueueTimeP90 90%分位服务端排队时间（毫秒）
+	QueueTimeP90 float32 `json:"queueTimeP90,omitempty"`
+
+	// QueueTimeP99 99%分位服务端排队时间（毫秒）
+	QueueTimeP99 float32 `json:"queueTimeP99,omitempty"`
+
 	// RequestsPerSecond 每秒请求数（QPS）
 	RequestsPerSecond float32 `json:"requestsPerSecond,omitempty"`
 
@@ -173,6 +197,18 @@ type RequestExperimentStats struct {
 	// ResponseTimeP99 99%分位响应时间（毫秒）
 	ResponseTimeP99 float32 `json:"responseTimeP99,omitempty"`
 
+	// ServiceRate 单个工作者的服务率 mu（请求/秒）
+	ServiceRate float32 `json:"serviceRate,omitempty"`
+
+	// ServiceTimeP50 50%分位服务端计算时间（毫秒）
+	ServiceTimeP50 float32 `json:"serviceTimeP50,omitempty"`
+
+	// ServiceTimeP95 95%分位服务端计算时间（毫秒）
+	ServiceTimeP95 float32 `json:"serviceTimeP95,omitempty"`
+
+	// ServiceTimeP99 99%分位服务端计算时间（毫秒）
+	ServiceTimeP99 float32 `json:"serviceTimeP99,omitempty"`
+
 	// StartTime 开始时间
 	StartTime time.Time `json:"startTime,omitempty"`
 
@@ -188,7 +224,7 @@ type RequestExperimentStats struct {
 	// TotalRequests 总请求数
 	TotalRequests int `json:"totalRequests,omitempty"`
 
-	// Utilization 服务器利用率（lambda/mu）
+	// Utilization 服务器利用率（lambda/mu），有 Server-Timing 时按服务端计算时间估算
 	Utilization float32 `json:"utilization,omitempty"`
 }
 
@@ -1460,47 +1496,51 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa71MTSfr/V1L9/V7VrhVJWJctSdW98Dxrl6u7Mxh8tVJXY9LA7M0ve3osOStVYVdN",
-	"UCBRUX4K4qpEXQnseogE5I+59Ezyin/haronk0mmJxAF17p3Sab76ac/z4/P8zyZ6yCpypqqQAXrIHYd",
-	"6MkRKAv04zmEVHQB6pqq6ND+QUOqBhEWIX0M7cf2hxTUk0jUsKgqIAZq03PVUsnaKJOlOyAM8KgGQQzo",
-	"GInKMEiHAbymQSTKUMF9Kf9ua2GL3PyNrC3VXk70/RmEgWJIknBZgiCGkQE58mSo68IwDFKkWnpulW9V",
-	"9lbMsRJPHSzKUMeCrAUJMGc2azNvQBgMqUgWMIiBlIDhSXufX146DBC8YogIpkDse4/wQXepevkHmMT2",
-	"0d9BQcIjwQDrWMAGg/qaIGs2BmCE7hnt8CZk7BnZfmv+nDGXn3V0nzAwNPrEJ9JcnCS3V6p7herKBBO5",
-	"v5OzVu/t74w3xIgKhsMQ2XKuQqTTnXxB1njOXPwFhD1X7e6KdkW5EPuQvACvGFDH51zX8oOZRFDAMHUG",
-	"c9DJLZDydoe4NInwSaT+a+bz1b117mYDCW13ToxZ5dd+WAOCwQMzVFIDXHsxwVb5vvlouf1VD4y49hHs",
-	"iV3fziuaznGAUt5avVctvTU3fjQfrO/v5PrjiSA30rGAcLsbkp0MWb3ToTEbkcYF7fammRmzfVMxZDus",
-	"kaEo9k57o6ppMAXCNItKENPPLDMO8mJUQMMQ98V5iW/NfJw9G79YKe9Zi8ssKvriZHGdPMqAQFFxFeFD",
-	"CrNelUj+Zy6qNi6qwRFU3bxpzmweHN+HCsq/ijpuQyfuOvpVxFCmH/4fwSEQA/8XafBUxCGpiD/uG4oI",
-	"CAmj9LuKBYnjdpmyE2wP1mvZ/IdeKoEFpnDzbYSrEAnDsH7dAI999xt5lCX3J8n2tIuxWXrlwux675Ck",
-	"CrihomLIl5nlgjNJUA7pIGccTbagVYSAAxnamsra+s29J7lbZmn6sDf/8Cw0JIgSTDmW5EX9043qm2du",
-	"QuJiJgk6vqjZQKR4lJYhhSlz4Y35cL3DTCQL19r7jC376epH+YwsKgefsZ7/qDOQg24cogRMqkqqo7x/",
-	"GPmNC8R7on7pPdE/kNytyu7kR17Dc0wv55jeYzimh3NMz9Ef08s5pvcojmnH0Z81O+tGMgl1fciQgpOD",
-	"mSuQ28vtkwMeQaoxPKLxGNW7nxSWSKFQy+b3d3Lsl0gHMFNea6NoptxeSwOLkvivIPagJQOZK5LcC2u6",
-	"yHK0JMiXU0JENg6nIo8/ExBdFZPwrKoMicOBx94sko1M7eaktbsGwi3M2mkN6TYU3VGurT64IvN0Kr1f",
-	"dXV/c7qru6s7Gj3aWs095HS0o8KtVp6trj0NKt9cqaeihyt7EnZI+2of5wd/9dNJc9SA8Wz8YvXNcnV3",
-	"1/z3nWrpAVsHPrABIdOlylbGfJytlm5ZvzxvOgjBKyfhNe1kNNp9FF1Kk4fJwjVRthNRdzQapVzrfO3I",
-	"fO3bQI/5vCee+uagE1vGE004NrRhCAzy3QAbenANnzQQgorHQ7j22b1PxifZ5KCy9dqav1Ev1vZ3cuT5",
-	"j+bSovnrirk47hhyscjWktxcZWvberHdVMcegiboeU5ctZJFHCopRhYXHNoYPGio45zDx0fVvOGhGxIv",
-	"OgJr9sY45cHeB9bsZGzRfP2kQ4b9iFpaVATJ7YE66tXYrjSj6USQ8eh1GmZzY7jB7QfOh+yfRGVIpR6q",
-	"KlhIUqMoguxknVBClA2J2iQUR2rdms2afDcwEHdoO3+3lhljDrW/M1HZul+bKVrTxcr2FCncbcnkbDHL",
-	"a2z7JeWScuIEe8o4LnbixCXlZIgxgcu8/8mM9ccT1utxtogsFp1HhRK5XbQtnJmv7mWtqRJ58hPJz9ay",
-	"+erae2t3zZZljmfcCKrs7tkUvrBFChN2tHnYlR7rERALDZy58O25gX/0xcP1j/HzFwbCof54Ihwa6Pvb",
-	"ufMXB9gNyMI2WZtnd2q6B/up+rZE3t+IheLnEwOhSFKQkjbEsLGgsns/FrqeDn1hvdj+S+L830npXXVj",
-	"5ctWJGKhFkRDXyQ1QxflkzpEVyH6kmnTH0+YU6skt+koQZa3K9tT/fEEydv5mj1zlH1y15rKUpRK+crW",
-	"S+fXmTe1+enQH0Pdkf54ImSt3musYOHEVjD4K1uZytbLYRWpBhYV6FjZY1/qLntL5tSzys4smXjoqMWA",
-	"sh+GBpCg6JqKcKX8zJwumRNjtVez5N0myd9lG+k1nk5a00X23dx4bC/NF2qvZs3MavWn3UsKTduYRoQT",
-	"YKEEVFIQhc7E+4Bn7OqMU9NhoGpQETQRxMCprmjXKbvAEvAIjb1I0q3LhiFvHDT1luQfOgncFwstvsVm",
-	"7nYaC/BrltvszEhDz0494FuIm0vERtdCNfwqGq2HsTPmFTRNEpNUQuQHnWVVlnMOykjNB9E0watGvbeh",
-	"6UU3ZFlAoy4e3mV0QaRlltUGSxanXiydrmb+BsnNVFeKPoTYFK0ll+rUikiQIYZIB7Hv/dOgcZZEq3tZ",
-	"s/zUra1E++EVA6JREK5nRIfgwh4YU3BIoFwGBEnquNcK0108YvUT4DRZWHJLAjaaq80VSG4zQFlJlEXM",
-	"17WnpRw7oDYaPEZXaz8L5bieU/swF0iHQc8RKtP8v16g35O5IhvQcZ2+VUFN1QP/WrGTmidrmg/Xrfkb",
-	"fp/3uTq/6wDudOlPamr0EKi4RUOrdkENR3M51Nou1Gv2U9FoOnzYVNO2f0o3l5gYGTDtc8bu43PGNg7I",
-	"ag06s7Ct/PWndMO6h9j8XfdEW4XeT6dCvfQlb38lr2fJ4ucWi8w+nFBqpaHIda9TpyN6vWRvx/N1JmL/",
-	"oVvl5erail1LTa7xeDugwD+AmIK6dZrr7cKkkepb2tXmgPFGYivNfNLM7rQ1Qb7EUKxXE7Y7f/2p3bmy",
-	"NflZOnOT1zXBdKAzq/SlhwAOYh3xRJaszR+Wd1SNRzv/047MnV0EEwMD1UMMv68b/w6sYHMCReFz4wSq",
-	"VBAnsBeIAjM/e0eI39vRl4jcUUxzyLB3mc6OwOQ/j7Nla3llKhgbqqsPmMZbUAyMxsiwDQ3ywaA9MAOD",
-	"3JvwTzPdN878/W29vTrGUG4a0wai5NgyuKd1F9gr6LSFl/nqgwedDR501laDMDCQBGJgBGMtFolIalKQ",
-	"RlQdx05HQXow/d8AAAD//5lERdN5KAAA",
+	"H4sIAAAAAAAC/9xaW1MbR/b/Kqr+/7cq8cpIxCEVVLUPWa8rYWuzFkh+iqmtsdTAZDUXZnpSZl2qEgm2",
+	"BAFJGGxuMoTEBAXHEiReIAjMh1n1zOjJX2Gru0fXmRESF8e1L9Sg6T7n9Ln9zjk9D0BEEmRJhCJSQeAB",
+	"UCNjUODo4y1FkZQhqMqSqELyg6xIMlQQD+lrSF6ThyhUIwovI14SQQBUFlfMYtHYK+H1b4EXoAkZggBQ",
+	"kcKLoyDuBfC+DBVegCIaiNp3G2uH+OGvuLBe2Zkd+AvwAlGLxbh7MQgCSNGgAz0Bqio3Ct0EMYs/GqVH",
+	"5dNNfbLoJA7iBagiTpDdCOhL+5WlV8ALRiRF4BAIgCiH4HWyz04v7gUKHNd4BUZB4IsG4sO1pdK9L2EE",
+	"EdafQS6GxtwVrCIOafQJ3ucEmegAjNE9E12eBE9u4aMD/YeEvrHV1Xm8QJPpGxtJPTeHZzbN06y5OctI",
+	"vjlOGduP3xxP18nwIoKjUCF0voKKSnc6EzKmU3ruZ+BtOGpvj7/H76himyaH4LgGVXSr5lp2ZUYUyCEY",
+	"/QQ5aCe1hktHXeqliYSNIvVfPZMxT3cdN2sK13bn7KRRemlXq0swNKgZitGwo70YYaO0oD/baH/UMyOu",
+	"fQQ3xK5t57isOjhAMWNsPzaLB/re1/qT3TfHqcFgyM2NVMQpqN0J8XECb3/bpTHrkeaotJl9PTEJiHI1",
+	"gYS1ooki2Uk2SrIMo8BLs2gMIvrMMuOwU4xyyihEA0GnxFfQv0veDN4pl06N3AaLioEgzu3iZwngSioo",
+	"KahDYsaLIs784KhVohdJcyBk7j/Ul/bPju+OgvJvvIrawEltHf2XR1CgD/+vwBEQAP/nq+OUzwIpn40F",
+	"qAvCKQo3Qf+XEBdzcLtEyQq2J7uVZOa8hwohDqn203BfQYUbhYMa1GC4TQIlRvntV/wsqacfV5Y3aprW",
+	"iy+osmf1Z1tmcscTgspXULke5gVeHGU2qLn2SEziUF1+URPuMbNaQlR17hI2lD1emMNHizb2XfEhMvKR",
+	"zo5rFjaNwlKnx50tH87h7Aumo06linCRMfgZj4ZIqnWIkeMF/HIZz5+UD18a6aRnjEeq7z3y948Cr6pQ",
+	"fb8rLqpbFLKD6zNbeH7GWJ1qZMuynZ7bIUkrW2SvrJBd2i8fHvlbzsqL6KMPHSOYivE5lbsrQQjzqiyd",
+	"cXLHLjfU6gKlLgefaN3KIdea0EgniXwrr3HqkV5c7NShzo97Ixwfg1ErdzjhzPM989VWDQIddRbjVHRH",
+	"JoqIOkVXAmfT+tor/elul9gncPfbJwhC+/n2hRKEwItn89jNXIjHeDXVBvv8dgZ9/j/g1KPyyVwtD7kk",
+	"3G6Z9Tsw678yZv0OzPovlZliOWkQKiEYkcRoVwVbJ/TrftDeUhfyhiY2bW10iWz6HNj0XT6bdk5wITYq",
+	"A3Dn1InnnpQPd/DBVvkkZyYeGqtTlsOlkx5Be3OcYg7h655fF0HrUjZ0z66tra6AXUeBezF2bTqjd7on",
+	"UrVIBKrqiBZzB0g9lcUzG+0BEo0pkjY6Jmuo/X6cXcfZbCWZOZfP0m6ijaCJUnspNcTH+H+5VVDUE/BK",
+	"Hqd+MhbzrE6JccK9KOcjMTZNKuTcdHN57CEd2uy0oxeVj3eNwlIHB3Pqdax6/qYkjvCjrsI+zOO9ROXh",
+	"nHFSAN6WLqjbfr82/On1O1r43N1zw1Sp/4Oe3o8+7unt6fX7L7evrjH52N9Vk10pLZuF526tdo3qDX9n",
+	"LWqIJAJbn2r9YO9Uuxlk1dV4M3jHfLVhnpzo//7WLD5h68A5h0V4sVg+TOjfJc3iI+PnH5sYKXD8Orwv",
+	"X/f7ey9jotTkYQJ3nxdI+ur1+/20SrX+7cp87Ud2DeZr5Hjjo7M4toySm/RYl4ZpYNjZDZCmus9bIpqi",
+	"QBHdam+fkwU8PcemvKRPXp2qtjlvjlP4x6/19Zz+y6aem7YMmcuztTi1Uj48Mn46auoAOwAXys+Kq1aI",
+	"CUIxyiBmyAKb4bMG8BYfZ/1IcmN4qFrMKTpcu9366PvJ6Tm7XTyZ019+3yUuX6AL5UUuVptXdTVXY7vi",
+	"DNxDbsajx6mbrRbD9YrgzFk++YkXR+i8JiKJiItQo4icYGUdT4gXtBi1iSeoSFVrNkvyWTgctMA+M19J",
+	"TDKHonOkhcpS3ljMl4/SODvfksnZYpbX2Pa74l3x2jX2lmFc4Nq1u+J1T+NIBa/k/5OYHAyGjJfTbBHO",
+	"5a1X2SKeyRMLJ1bN06SRLuLvv8GZ5UoyYxZeGycFQkufTtQiqHxySoB/7RBnZ0m0NaArZdtAIOAJfzL0",
+	"6a3wPwaC3upj8PZQ2OsZDIa8nvDA57du3wmzE+C1I1xYZWdqOgf7yTwo4tdTAU/wdijs8UW4WISoGNYX",
+	"lE8WAp4Hcc97xk9Hfw3d/jsu/mbubb7fqomAp0WjnvcisqbywnWVVizvM2kGgyE9vY1T+5YQeOOofJQe",
+	"DIZwhuRr9s4S9vt5I52kWipmyoc71q9Lryqri54/eXp9g8GQx9h+XF/BwomtYOovHybKhzujkiJpiBeh",
+	"ZeUG+1J3OV3X01vl42U8+9QSiymKvPSEFU5UZUlB5dKWvljUZycrL5bxb/s4M8820mM8nzMW8+x/fe87",
+	"sjSTrbxY1hPb5jcnd0WathGNCCvAPCEoRqHi+SQ4ABquyKyrr7gXSDIUOZkHAXCjx99zA3iBzKExGnu+",
+	"SK0uG4VOo/v0Ac48tRK4LRZafIvdj5I05uLXLLeRzEhDj6Qe8ClEzSVivVGlEn7g91fD2LqS42Q5xkco",
+	"Bd+XKsuqLOeclZGaGdE04VSNNp6GphdVEwROmajpo3EZXeBruXdoo0sWp426tHqh1SmcWjI38zYNsRuP",
+	"llyqUisqnAARVFQQ+MI+R51mSdQ8Teql57XaiicvxzWoTABvNSNaAOdtUGMUjnAUywAXi3XdoXnpLidg",
+	"tQPgIl5br5UE7BqlspLFqX0XYWO8wCNnWftayrEzaqPhK3S19vdWDq5n1T7MBeJe0HeJwjR/g+Hq93gl",
+	"z0bbjk7fKqAsqa7X4CSpNWRN/emusTpl93mbqzt3HaA2UPyzFJ3oQCu1oqFVOreGo7kcam0XqjX7Db8/",
+	"7u001bTtn+LNJSa5gojbnLH36pyxjQOyWoNOOoiVP3ybblj1EILfVU8kIvS/PRGqpS8++IXcsOXetVhk",
+	"9nEIpVYY8j1odOq4T0XcmdhUQyL2vZNR2jALm6SWmis44bZLgX8GMLl16zTXk8Kknupb2tXmgGmMxFaY",
+	"eauZ3Wpr3HyJabFaTRB3/vBtuzO5+H4XnbnJ65rUdKYzS/QDNRcMYh3xbBIXVjvFHUl2gp3/aUd2nF24",
+	"AwNTagMw/L5u/DugAsEEqoV3DROoUG6YwD72dM387HtO596OfvBZG8U0hwz77vTmGIz88ypbtpbPW911",
+	"Q2W1Kab+xSpTRn1k2AYGnZVBe2CmDPx41j7NrH0dbO9vq+3VFYZy05jWVUuWLd172toC6+rROfNVBw8q",
+	"GzxYd5TACzQlBgJgDCE54PPFpAgXG5NUFPjYD+LD8f8OANd3SYYlLgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file